@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
 	"log"
 	"net/http"
 	"os"
@@ -10,42 +11,148 @@ import (
 	"syscall"
 	"time"
 
+	"weather-api-wrapper/internal/adapters/input/http/dto"
 	"weather-api-wrapper/internal/adapters/input/http/handlers"
 	"weather-api-wrapper/internal/adapters/input/http/routes"
+	"weather-api-wrapper/internal/adapters/output/breaker"
 	"weather-api-wrapper/internal/adapters/output/config"
+	"weather-api-wrapper/internal/adapters/output/memory"
+	"weather-api-wrapper/internal/adapters/output/nocache"
 	"weather-api-wrapper/internal/adapters/output/redis"
+	"weather-api-wrapper/internal/adapters/output/tiered"
 	"weather-api-wrapper/internal/adapters/output/weatherapi"
+	"weather-api-wrapper/internal/adapters/redact"
 	weatherapp "weather-api-wrapper/internal/application/weather"
+	"weather-api-wrapper/internal/bootstrap"
+	"weather-api-wrapper/internal/domain/weather"
 )
 
 func main() {
 	// 1. Load configuration (configuration adapter)
 	cfg := config.Load()
+	// Mask secrets in anything logged from here on
+	log.SetOutput(redact.New(cfg.WeatherAPIKey, cfg.AdminToken).Writer(os.Stderr))
 	log.Println("Configuration loaded successfully")
 
 	// 2. Initialize output adapters (secondary/driven)
 
-	// Initialize Redis cache adapter
-	redisCache, err := redis.NewCache(cfg.RedisHost, cfg.RedisPort)
-	if err != nil {
-		log.Fatalf("Failed to connect to Redis: %v", err)
+	// Initialize cache adapters on the configured backend
+	var redisCache *redis.Cache
+	switch cfg.CacheBackend {
+	case config.CacheBackendRedis:
+		// Redis being down is not fatal: the cache is bypassed until it is reachable
+		redisCache = redis.NewCache(cfg.RedisHost, cfg.RedisPort,
+			redis.WithProvider(weatherapi.ProviderName),
+			redis.WithCompression(cfg.CacheCompression),
+		)
+		if redisCache.Available() {
+			log.Println("Redis cache connected successfully")
+		} else {
+			log.Println("Redis cache unavailable, serving without it until it is reachable")
+		}
+	case config.CacheBackendMemory, config.CacheBackendNone:
+		log.Printf("Using %s cache backend", cfg.CacheBackend)
+	default:
+		log.Fatalf("Invalid CACHE_BACKEND %q: expected redis, memory or none", cfg.CacheBackend)
 	}
-	log.Println("Redis cache connected successfully")
+	weatherCache := newCache[weather.Weather](cfg, redisCache)
+	forecastCache := newCache[weather.Forecast](cfg, redisCache)
+	historyCache := newCache[weather.History](cfg, redisCache)
+	alertCache := newCache[weather.WeatherAlerts](cfg, redisCache)
+	astronomyCache := newCache[weather.AstronomyReport](cfg, redisCache)
+	searchCache := newCache[weather.LocationSearch](cfg, redisCache)
 
 	// Initialize Weather API client adapter
-	weatherAPIClient := weatherapi.NewClient(cfg.WeatherAPIKey, cfg.WeatherAPIBaseURL)
+	weatherAPIClient, err := bootstrap.NewWeatherAPIClient(cfg)
+	if err != nil {
+		log.Fatalf("Invalid weather API settings: %v", err)
+	}
 	log.Println("Weather API client initialized")
 
+	// Fail fast while the weather API is unhealthy
+	weatherProvider := breaker.NewProvider(weatherapi.ProviderName, weatherAPIClient, breaker.Settings{
+		Window:         cfg.BreakerWindow,
+		MinRequests:    cfg.BreakerMinRequests,
+		FailureRate:    cfg.BreakerFailureRate,
+		CoolDown:       cfg.BreakerCoolDown,
+		HalfOpenProbes: cfg.BreakerHalfOpenProbes,
+	})
+
 	// 3. Initialize application service (core business logic)
-	weatherService := weatherapp.NewService(weatherAPIClient, redisCache)
-	log.Println("Weather application service initialized")
+	// All services count their cache and upstream activity together
+	stats := weatherapp.NewStats()
+	serviceOpts := append(bootstrap.ServiceOptions(cfg), weatherapp.WithStats(stats))
+	weatherService := weatherapp.NewService(weatherProvider, weatherCache, serviceOpts...)
+	forecastService := weatherapp.NewForecastService(weatherProvider, forecastCache, serviceOpts...)
+	historyService := weatherapp.NewHistoryService(weatherProvider, historyCache, serviceOpts...)
+	alertService := weatherapp.NewAlertService(weatherProvider, alertCache, serviceOpts...)
+	astronomyService := weatherapp.NewAstronomyService(weatherProvider, astronomyCache, weatherCache, serviceOpts...)
+	searchService := weatherapp.NewSearchService(weatherProvider, searchCache, serviceOpts...)
+	cacheAdminService := weatherapp.NewCacheAdminService(weatherCache, forecastCache, historyCache, alertCache, astronomyCache, searchCache)
+	healthService := weatherapp.NewHealthService(weatherProvider)
+	log.Println("Weather application services initialized")
+
+	publishMetrics(stats, map[string]any{
+		"current":   weatherCache,
+		"forecast":  forecastCache,
+		"history":   historyCache,
+		"alerts":    alertCache,
+		"astronomy": astronomyCache,
+		"search":    searchCache,
+	})
+
+	// Keep popular locations fresh in the background
+	refreshCtx, stopRefresh := context.WithCancel(context.Background())
+	defer stopRefresh()
+	if cfg.RefreshInterval > 0 && cfg.CacheBackend != config.CacheBackendNone {
+		policy := weatherapp.RefreshPolicy{
+			Interval:     cfg.RefreshInterval,
+			TopLocations: cfg.RefreshTopLocations,
+			Lead:         cfg.RefreshLead,
+			Budget:       cfg.RefreshBudget,
+		}
+		if cfg.RefreshLocationsFile != "" {
+			pinned, err := config.LoadLocations(cfg.RefreshLocationsFile)
+			if err != nil {
+				log.Fatalf("Invalid REFRESH_LOCATIONS_FILE %q: %v", cfg.RefreshLocationsFile, err)
+			}
+			policy.Pinned = pinned
+		}
+		go weatherapp.NewRefresher(weatherService, policy).Run(refreshCtx)
+		log.Printf("Background refresh started for %d pinned and %d popular locations every %s", len(policy.Pinned), policy.TopLocations, policy.Interval)
+	}
 
 	// 4. Initialize input adapter (primary/driving)
-	weatherHandler := handlers.NewWeatherHandler(weatherService)
-	log.Println("HTTP handler initialized")
+	defaultUnits, err := weather.ParseUnitSystem(cfg.DefaultUnits)
+	if err != nil {
+		log.Fatalf("Invalid DEFAULT_UNITS %q: %v", cfg.DefaultUnits, err)
+	}
+	weatherHandler := handlers.NewWeatherHandler(weatherService, handlers.WithDefaultUnits(defaultUnits))
+	forecastHandler := handlers.NewForecastHandler(forecastService, handlers.WithDefaultUnits(defaultUnits))
+	historyHandler := handlers.NewHistoryHandler(historyService, handlers.WithDefaultUnits(defaultUnits))
+	alertHandler := handlers.NewAlertHandler(alertService)
+	astronomyHandler := handlers.NewAstronomyHandler(astronomyService)
+	searchHandler := handlers.NewSearchHandler(searchService)
+	adminHandler := handlers.NewAdminHandler(cacheAdminService)
+	statsHandler := handlers.NewStatsHandler(stats)
+	healthHandler := handlers.NewHealthHandler(healthService)
+	log.Println("HTTP handlers initialized")
 
 	// 5. Setup routes with middleware chain
-	router := routes.SetupRoutes(weatherHandler)
+	router := routes.SetupRoutes(routes.Handlers{
+		Weather:   weatherHandler,
+		Forecast:  forecastHandler,
+		History:   historyHandler,
+		Alerts:    alertHandler,
+		Astronomy: astronomyHandler,
+		Search:    searchHandler,
+		Admin:     adminHandler,
+		Stats:     statsHandler,
+		Health:    healthHandler,
+	}, cfg.AdminToken)
+	if cfg.AdminToken == "" {
+		log.Println("ADMIN_TOKEN not set, admin endpoints disabled")
+	}
 	log.Println("Routes configured with middleware")
 
 	port := ":8080"
@@ -81,6 +188,9 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Stop refreshing before the cache goes away
+	stopRefresh()
+
 	// Attempt graceful shutdown of HTTP server
 	if err := server.Shutdown(ctx); err != nil {
 		log.Printf("HTTP server shutdown error: %v", err)
@@ -93,11 +203,48 @@ func main() {
 	}
 
 	// Close Redis connection
-	if err := redisCache.Close(); err != nil {
-		log.Printf("Redis cache close error: %v", err)
-	} else {
-		log.Println("Redis cache closed")
+	if redisCache != nil {
+		if err := redisCache.Close(); err != nil {
+			log.Printf("Redis cache close error: %v", err)
+		} else {
+			log.Println("Redis cache closed")
+		}
 	}
 
 	log.Println("Shutdown complete")
 }
+
+// newCache builds the cache adapter for one data type on the configured backend
+func newCache[T any](cfg *config.Config, redisCache *redis.Cache) tiered.Tier[T] {
+	switch cfg.CacheBackend {
+	case config.CacheBackendMemory:
+		return memory.NewCache[T](cfg.MemoryCacheSize)
+	case config.CacheBackendNone:
+		return nocache.Cache[T]{}
+	default:
+		// Put an in-process cache in front of Redis
+		return tiered.NewCache[T](memory.NewCache[T](cfg.MemoryCacheSize), redis.NewStore[T](redisCache), cfg.MemoryCacheTTL)
+	}
+}
+
+// publishMetrics exports the service statistics, and the per-tier statistics of
+// the tiered caches, as expvar variables
+func publishMetrics(stats *weatherapp.Stats, caches map[string]any) {
+	expvar.Publish("cache", expvar.Func(func() any {
+		snapshot, err := stats.GetStats(context.Background())
+		if err != nil {
+			return nil
+		}
+		return dto.StatsFromDomain(snapshot, time.Now())
+	}))
+
+	expvar.Publish("cache_tiers", expvar.Func(func() any {
+		tiers := make(map[string]tiered.Stats)
+		for dataType, cache := range caches {
+			if c, ok := cache.(interface{ Stats() tiered.Stats }); ok {
+				tiers[dataType] = c.Stats()
+			}
+		}
+		return tiers
+	}))
+}
@@ -0,0 +1,77 @@
+// Command warmup preloads the shared Redis cache with the current weather of a list of locations,
+// e.g. before a deployment or after a cache flush.
+//
+// Usage:
+//
+//	warmup -file locations.txt
+//
+// The file holds one location per line: a place name, "lat,lon", "postcode:..." or "iata:...".
+// Upstream calls are limited to REFRESH_BUDGET per minute; the other settings are the server's.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"weather-api-wrapper/internal/adapters/output/config"
+	"weather-api-wrapper/internal/adapters/output/redis"
+	"weather-api-wrapper/internal/adapters/output/weatherapi"
+	"weather-api-wrapper/internal/adapters/redact"
+	weatherapp "weather-api-wrapper/internal/application/weather"
+	"weather-api-wrapper/internal/bootstrap"
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+func main() {
+	file := flag.String("file", "", "file listing the locations to warm up, one per line")
+	flag.Parse()
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg := config.Load()
+	// Mask secrets in anything logged from here on
+	log.SetOutput(redact.New(cfg.WeatherAPIKey).Writer(os.Stderr))
+	queries, err := config.LoadLocations(*file)
+	if err != nil {
+		log.Fatalf("Invalid locations file %q: %v", *file, err)
+	}
+
+	// Only Redis is shared with the server; warming any other backend would be lost on exit
+	if cfg.CacheBackend != config.CacheBackendRedis {
+		log.Fatalf("Warm-up needs CACHE_BACKEND=redis, got %q", cfg.CacheBackend)
+	}
+	redisCache := redis.NewCache(cfg.RedisHost, cfg.RedisPort,
+		redis.WithProvider(weatherapi.ProviderName),
+		redis.WithCompression(cfg.CacheCompression),
+	)
+	defer redisCache.Close()
+	if !redisCache.Available() {
+		log.Fatalf("Redis is unavailable at %s:%s", cfg.RedisHost, cfg.RedisPort)
+	}
+
+	weatherAPIClient, err := bootstrap.NewWeatherAPIClient(cfg)
+	if err != nil {
+		log.Fatalf("Invalid weather API settings: %v", err)
+	}
+	weatherService := weatherapp.NewService(weatherAPIClient, redis.NewStore[weather.Weather](redisCache), bootstrap.ServiceOptions(cfg)...)
+	var warmUp input.WarmUpCacheUseCase = weatherapp.NewRefresher(weatherService, weatherapp.RefreshPolicy{
+		Budget: cfg.RefreshBudget,
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Warming up %d locations at up to %d upstream calls per minute", len(queries), cfg.RefreshBudget)
+	warmed, err := warmUp.WarmUp(ctx, queries)
+	if err != nil {
+		log.Printf("Warm-up interrupted: %v", err)
+	}
+	log.Printf("Warmed up %d of %d locations", warmed, len(queries))
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// AlertsResponse is the HTTP response DTO for the alerts endpoint
+type AlertsResponse struct {
+	Location string          `json:"location"`
+	Alerts   []AlertResponse `json:"alerts"`
+}
+
+// AlertResponse is a single government weather alert
+// Effective and expires are RFC 3339 timestamps, omitted when unknown
+type AlertResponse struct {
+	Headline    string   `json:"headline"`
+	Event       string   `json:"event"`
+	Severity    string   `json:"severity"`
+	Urgency     string   `json:"urgency"`
+	Certainty   string   `json:"certainty"`
+	Category    string   `json:"category"`
+	Areas       []string `json:"areas"`
+	Effective   string   `json:"effective,omitempty"`
+	Expires     string   `json:"expires,omitempty"`
+	Description string   `json:"description"`
+	Instruction string   `json:"instruction"`
+}
+
+// AlertsFromDomain maps domain weather alerts to their HTTP response DTO
+func AlertsFromDomain(wa *weather.WeatherAlerts) AlertsResponse {
+	return AlertsResponse{
+		Location: wa.Location.Name,
+		Alerts:   alertListFromDomain(wa.Alerts),
+	}
+}
+
+func alertListFromDomain(alerts []weather.Alert) []AlertResponse {
+	result := make([]AlertResponse, 0, len(alerts))
+	for _, alert := range alerts {
+		areas := alert.Areas
+		if areas == nil {
+			areas = []string{}
+		}
+
+		result = append(result, AlertResponse{
+			Headline:    alert.Headline,
+			Event:       alert.Event,
+			Severity:    alert.Severity,
+			Urgency:     alert.Urgency,
+			Certainty:   alert.Certainty,
+			Category:    alert.Category,
+			Areas:       areas,
+			Effective:   formatTime(alert.Effective),
+			Expires:     formatTime(alert.Expires),
+			Description: alert.Description,
+			Instruction: alert.Instruction,
+		})
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// AstronomyReportResponse is the HTTP response DTO for the astronomy endpoint
+type AstronomyReportResponse struct {
+	Location string `json:"location"`
+	Date     string `json:"date"`
+	AstronomyResponse
+	DaylightMinutes int                 `json:"daylight_minutes"`
+	GoldenHour      *GoldenHourResponse `json:"golden_hour,omitempty"`
+	Estimated       bool                `json:"estimated"`
+}
+
+// GoldenHourResponse holds the morning and evening golden-hour windows
+type GoldenHourResponse struct {
+	Morning TimeWindowResponse `json:"morning"`
+	Evening TimeWindowResponse `json:"evening"`
+}
+
+// TimeWindowResponse is a time interval formatted as RFC 3339 timestamps
+type TimeWindowResponse struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// AstronomyReportFromDomain maps a domain astronomy report to its HTTP response DTO
+func AstronomyReportFromDomain(r *weather.AstronomyReport) AstronomyReportResponse {
+	response := AstronomyReportResponse{
+		Location:          r.Location.Name,
+		Date:              r.Date.Format(weather.DateLayout),
+		AstronomyResponse: AstronomyFromDomain(r.Astronomy),
+		DaylightMinutes:   int(r.Astronomy.DaylightDuration().Minutes()),
+		Estimated:         r.Estimated,
+	}
+
+	if morning, evening, ok := r.Astronomy.GoldenHours(); ok {
+		response.GoldenHour = &GoldenHourResponse{
+			Morning: timeWindowFromDomain(morning),
+			Evening: timeWindowFromDomain(evening),
+		}
+	}
+
+	return response
+}
+
+func timeWindowFromDomain(w weather.TimeWindow) TimeWindowResponse {
+	return TimeWindowResponse{
+		Start: formatTime(w.Start),
+		End:   formatTime(w.End),
+	}
+}
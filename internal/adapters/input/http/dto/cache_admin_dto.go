@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// CacheEntryResponse describes a cache entry for the admin endpoints
+// ttl_seconds is 0 for entries that never expire; age_seconds is omitted when the entry has no data
+// stored_at and provider are only reported by caches that record them
+type CacheEntryResponse struct {
+	Key        string     `json:"key"`
+	AliasOf    string     `json:"alias_of,omitempty"`
+	Location   string     `json:"location,omitempty"`
+	TTLSeconds int64      `json:"ttl_seconds"`
+	AgeSeconds *int64     `json:"age_seconds,omitempty"`
+	StoredAt   *time.Time `json:"stored_at,omitempty"`
+	Provider   string     `json:"provider,omitempty"`
+}
+
+// CacheEntryDetailResponse is a cache entry together with its cached value
+// The value is the detailed weather response, with every subtree and metric units
+type CacheEntryDetailResponse struct {
+	CacheEntryResponse
+	Value *WeatherDetailResponse `json:"value,omitempty"`
+}
+
+// CacheListResponse is the HTTP response DTO for listing cached current weather
+type CacheListResponse struct {
+	Prefix  string               `json:"prefix"`
+	Count   int                  `json:"count"`
+	Entries []CacheEntryResponse `json:"entries"`
+}
+
+// CacheInvalidationResponse reports how many cache entries an invalidation removed
+type CacheInvalidationResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+// CacheEntryFromDomain maps a cached weather entry to its HTTP response DTO, computing its age at now
+func CacheEntryFromDomain(c weather.CachedWeather, now time.Time) CacheEntryResponse {
+	response := CacheEntryResponse{
+		Key:        c.Key,
+		AliasOf:    c.AliasOf,
+		TTLSeconds: int64(c.TTL.Seconds()),
+		Provider:   c.Provider,
+	}
+	if !c.StoredAt.IsZero() {
+		response.StoredAt = &c.StoredAt
+	}
+	if c.Value != nil {
+		age := int64(c.Value.Age(now).Seconds())
+		response.Location = c.Value.Location.Name
+		response.AgeSeconds = &age
+	}
+	return response
+}
+
+// CacheListFromDomain maps a listing of cached weather to its HTTP response DTO
+func CacheListFromDomain(prefix string, cached []weather.CachedWeather, now time.Time) CacheListResponse {
+	entries := make([]CacheEntryResponse, 0, len(cached))
+	for _, c := range cached {
+		entries = append(entries, CacheEntryFromDomain(c, now))
+	}
+
+	return CacheListResponse{
+		Prefix:  prefix,
+		Count:   len(entries),
+		Entries: entries,
+	}
+}
+
+// CacheEntryDetailFromDomain maps a cached weather entry and its value to its HTTP response DTO
+func CacheEntryDetailFromDomain(c *weather.CachedWeather, now time.Time) CacheEntryDetailResponse {
+	response := CacheEntryDetailResponse{
+		CacheEntryResponse: CacheEntryFromDomain(*c, now),
+	}
+	if c.Value != nil {
+		value := WeatherDetailFromDomain(c.Value, nil, weather.UnitsMetric)
+		response.Value = &value
+	}
+	return response
+}
@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// DailyWeatherResponse is the daily summary shared by forecast and history responses
+// Temperatures, precipitation and wind speed are expressed in the response's unit system
+type DailyWeatherResponse struct {
+	Date               string                  `json:"date"`
+	MaxTemperature     float64                 `json:"max_temp"`
+	MinTemperature     float64                 `json:"min_temp"`
+	AvgTemperature     float64                 `json:"avg_temp"`
+	Condition          string                  `json:"condition_text"`
+	ChanceOfRain       int                     `json:"chance_of_rain"`
+	ChanceOfSnow       int                     `json:"chance_of_snow"`
+	TotalPrecipitation float64                 `json:"total_precip"`
+	MaxWind            float64                 `json:"max_wind"`
+	AvgHumidity        int                     `json:"avg_humidity"`
+	UVIndex            float64                 `json:"uv"`
+	Astronomy          AstronomyResponse       `json:"astronomy"`
+	Hours              []HourlyWeatherResponse `json:"hours"`
+}
+
+// HourlyWeatherResponse is a single hourly entry of a day
+type HourlyWeatherResponse struct {
+	Time          string  `json:"time"`
+	Temperature   float64 `json:"temperature"`
+	FeelsLike     float64 `json:"feels_like"`
+	Condition     string  `json:"condition_text"`
+	ChanceOfRain  int     `json:"chance_of_rain"`
+	Precipitation float64 `json:"precip"`
+	WindSpeed     float64 `json:"wind_speed"`
+	Humidity      int     `json:"humidity"`
+	IsDay         bool    `json:"is_day"`
+}
+
+// AstronomyResponse holds sun and moon times formatted as RFC 3339 timestamps
+// Moonrise and moonset are omitted on days without one
+type AstronomyResponse struct {
+	Sunrise          string `json:"sunrise,omitempty"`
+	Sunset           string `json:"sunset,omitempty"`
+	Moonrise         string `json:"moonrise,omitempty"`
+	Moonset          string `json:"moonset,omitempty"`
+	MoonPhase        string `json:"moon_phase"`
+	MoonIllumination int    `json:"moon_illumination"`
+}
+
+func dailyWeatherFromDomain(d weather.DailyWeather, units weather.UnitSystem) DailyWeatherResponse {
+	hours := make([]HourlyWeatherResponse, 0, len(d.Hours))
+	for _, hour := range d.Hours {
+		hours = append(hours, HourlyWeatherResponse{
+			Time:          formatTime(hour.Time),
+			Temperature:   units.Temperature(hour.Temperature.Celsius),
+			FeelsLike:     units.Temperature(hour.Temperature.FeelsLike.Celsius),
+			Condition:     hour.Condition.Text,
+			ChanceOfRain:  hour.ChanceOfRain,
+			Precipitation: units.Precipitation(hour.Precipitation.Millimeters),
+			WindSpeed:     units.Speed(hour.Wind.SpeedKph),
+			Humidity:      hour.Humidity,
+			IsDay:         hour.IsDay,
+		})
+	}
+
+	return DailyWeatherResponse{
+		Date:               d.Date.Format("2006-01-02"),
+		MaxTemperature:     units.Temperature(d.MaxTemp.Celsius),
+		MinTemperature:     units.Temperature(d.MinTemp.Celsius),
+		AvgTemperature:     units.Temperature(d.AvgTemp.Celsius),
+		Condition:          d.Condition.Text,
+		ChanceOfRain:       d.ChanceOfRain,
+		ChanceOfSnow:       d.ChanceOfSnow,
+		TotalPrecipitation: units.Precipitation(d.TotalPrecip.Millimeters),
+		MaxWind:            units.Speed(d.MaxWind.SpeedKph),
+		AvgHumidity:        d.AvgHumidity,
+		UVIndex:            d.UVIndex,
+		Astronomy:          AstronomyFromDomain(d.Astronomy),
+		Hours:              hours,
+	}
+}
+
+// AstronomyFromDomain maps domain astronomy data to its HTTP response DTO
+func AstronomyFromDomain(a weather.Astronomy) AstronomyResponse {
+	return AstronomyResponse{
+		Sunrise:          formatTime(a.Sunrise),
+		Sunset:           formatTime(a.Sunset),
+		Moonrise:         formatTime(a.Moonrise),
+		Moonset:          formatTime(a.Moonset),
+		MoonPhase:        a.MoonPhase,
+		MoonIllumination: a.MoonIllumination,
+	}
+}
+
+// formatTime renders a timestamp as RFC 3339, or an empty string for the zero time
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// ForecastResponse is the HTTP response DTO for the forecast endpoint
+type ForecastResponse struct {
+	Location string                 `json:"location"`
+	Units    UnitsResponse          `json:"units"`
+	Days     []DailyWeatherResponse `json:"days"`
+}
+
+// ForecastFromDomain maps a domain forecast to its HTTP response DTO in the requested unit system
+func ForecastFromDomain(f *weather.Forecast, units weather.UnitSystem) ForecastResponse {
+	days := make([]DailyWeatherResponse, 0, len(f.Days))
+	for _, day := range f.Days {
+		days = append(days, dailyWeatherFromDomain(day, units))
+	}
+
+	return ForecastResponse{
+		Location: f.Location.Name,
+		Units:    UnitsFromDomain(units),
+		Days:     days,
+	}
+}
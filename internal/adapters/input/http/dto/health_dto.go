@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// Service health statuses
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded" // an upstream provider is unavailable; cached data is still served
+)
+
+// UpstreamHealthResponse describes the circuit breaker state of an upstream provider
+type UpstreamHealthResponse struct {
+	Name     string    `json:"name"`
+	State    string    `json:"state"`
+	Since    time.Time `json:"since"`
+	Requests int       `json:"requests"`
+	Failures int       `json:"failures"`
+}
+
+// HealthResponse is the HTTP response DTO for the health endpoint
+type HealthResponse struct {
+	Status    string                   `json:"status"`
+	Upstreams []UpstreamHealthResponse `json:"upstreams"`
+}
+
+// HealthFromDomain maps a health report to its HTTP response DTO
+func HealthFromDomain(h *weather.HealthReport) HealthResponse {
+	upstreams := make([]UpstreamHealthResponse, 0, len(h.Upstreams))
+	for _, u := range h.Upstreams {
+		upstreams = append(upstreams, UpstreamHealthResponse{
+			Name:     u.Name,
+			State:    string(u.State),
+			Since:    u.Since,
+			Requests: u.Requests,
+			Failures: u.Failures,
+		})
+	}
+
+	status := HealthStatusOK
+	if h.Degraded() {
+		status = HealthStatusDegraded
+	}
+
+	return HealthResponse{
+		Status:    status,
+		Upstreams: upstreams,
+	}
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// HistoryResponse is the HTTP response DTO for the history endpoint
+type HistoryResponse struct {
+	Location string                 `json:"location"`
+	Units    UnitsResponse          `json:"units"`
+	Days     []DailyWeatherResponse `json:"days"`
+}
+
+// HistoryFromDomain maps domain historical weather to its HTTP response DTO in the requested unit system
+func HistoryFromDomain(h *weather.History, units weather.UnitSystem) HistoryResponse {
+	days := make([]DailyWeatherResponse, 0, len(h.Days))
+	for _, day := range h.Days {
+		days = append(days, dailyWeatherFromDomain(day, units))
+	}
+
+	return HistoryResponse{
+		Location: h.Location.Name,
+		Units:    UnitsFromDomain(units),
+		Days:     days,
+	}
+}
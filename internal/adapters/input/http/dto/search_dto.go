@@ -0,0 +1,40 @@
+package dto
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// LocationSearchResponse is the HTTP response DTO for the location search endpoint
+type LocationSearchResponse struct {
+	Query   string                      `json:"query"`
+	Results []LocationCandidateResponse `json:"results"`
+}
+
+// LocationCandidateResponse is a single location matching a search
+// ID is the provider's identifier for the location
+type LocationCandidateResponse struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Region    string  `json:"region"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lon"`
+}
+
+// LocationSearchFromDomain maps domain search results to their HTTP response DTO
+func LocationSearchFromDomain(s *weather.LocationSearch) LocationSearchResponse {
+	results := make([]LocationCandidateResponse, 0, len(s.Candidates))
+	for _, candidate := range s.Candidates {
+		results = append(results, LocationCandidateResponse{
+			ID:        candidate.ID,
+			Name:      candidate.Name,
+			Region:    candidate.Region,
+			Country:   candidate.Country,
+			Latitude:  candidate.Latitude,
+			Longitude: candidate.Longitude,
+		})
+	}
+
+	return LocationSearchResponse{
+		Query:   s.Query,
+		Results: results,
+	}
+}
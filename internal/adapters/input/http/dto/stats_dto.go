@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// CacheCountersResponse reports how requests for one type of data were served
+// hit_ratio is the share of requests served from cache, fresh or stale
+type CacheCountersResponse struct {
+	Hits          int64   `json:"hits"`
+	StaleServes   int64   `json:"stale_serves"`
+	Misses        int64   `json:"misses"`
+	SetFailures   int64   `json:"set_failures"`
+	UpstreamCalls int64   `json:"upstream_calls"`
+	HitRatio      float64 `json:"hit_ratio"`
+}
+
+// StatsResponse is the HTTP response DTO for cache statistics
+type StatsResponse struct {
+	Since                   time.Time                        `json:"since"`
+	UptimeSeconds           int64                            `json:"uptime_seconds"`
+	Total                   CacheCountersResponse            `json:"total"`
+	DataTypes               map[string]CacheCountersResponse `json:"data_types"`
+	UpstreamCallsByLocation map[string]int64                 `json:"upstream_calls_by_location"`
+}
+
+// CacheCountersFromDomain maps cache counters to their HTTP response DTO
+func CacheCountersFromDomain(c weather.CacheCounters) CacheCountersResponse {
+	return CacheCountersResponse{
+		Hits:          c.Hits,
+		StaleServes:   c.StaleServes,
+		Misses:        c.Misses,
+		SetFailures:   c.SetFailures,
+		UpstreamCalls: c.UpstreamCalls,
+		HitRatio:      c.HitRatio(),
+	}
+}
+
+// StatsFromDomain maps service statistics to their HTTP response DTO, computing the uptime at now
+func StatsFromDomain(s *weather.ServiceStats, now time.Time) StatsResponse {
+	dataTypes := make(map[string]CacheCountersResponse, len(s.ByDataType))
+	for dataType, c := range s.ByDataType {
+		dataTypes[dataType] = CacheCountersFromDomain(c)
+	}
+
+	byLocation := s.UpstreamCallsByLocation
+	if byLocation == nil {
+		byLocation = map[string]int64{}
+	}
+
+	return StatsResponse{
+		Since:                   s.Since,
+		UptimeSeconds:           int64(now.Sub(s.Since).Seconds()),
+		Total:                   CacheCountersFromDomain(s.Total()),
+		DataTypes:               dataTypes,
+		UpstreamCallsByLocation: byLocation,
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// UnitsResponse names the units of the unit-dependent numeric fields in a response
+type UnitsResponse struct {
+	System        string `json:"system"`
+	Temperature   string `json:"temperature"`
+	Speed         string `json:"speed"`
+	Pressure      string `json:"pressure"`
+	Precipitation string `json:"precipitation"`
+	Distance      string `json:"distance"`
+}
+
+// UnitsFromDomain describes a domain unit system for API clients
+func UnitsFromDomain(units weather.UnitSystem) UnitsResponse {
+	labels := units.Labels()
+	return UnitsResponse{
+		System:        string(units),
+		Temperature:   labels.Temperature,
+		Speed:         labels.Speed,
+		Pressure:      labels.Pressure,
+		Precipitation: labels.Precipitation,
+		Distance:      labels.Distance,
+	}
+}
@@ -0,0 +1,280 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// WeatherDetailSchemaVersion identifies the layout of WeatherDetailResponse
+// It is bumped whenever a field is renamed or removed
+const WeatherDetailSchemaVersion = 2
+
+// Selectable subtrees of WeatherDetailResponse, as accepted by the fields= query parameter
+const (
+	FieldLocation      = "location"
+	FieldTemperature   = "temperature"
+	FieldCondition     = "condition"
+	FieldWind          = "wind"
+	FieldPressure      = "pressure"
+	FieldPrecipitation = "precipitation"
+	FieldHumidity      = "humidity"
+	FieldCloudCover    = "cloud_cover"
+	FieldVisibility    = "visibility"
+	FieldUV            = "uv"
+	FieldRadiation     = "radiation"
+	FieldAirQuality    = "air_quality"
+	FieldAlerts        = "alerts"
+	FieldInsights      = "insights"
+)
+
+// WeatherFields lists every selectable subtree in response order
+var WeatherFields = []string{
+	FieldLocation,
+	FieldTemperature,
+	FieldCondition,
+	FieldWind,
+	FieldPressure,
+	FieldPrecipitation,
+	FieldHumidity,
+	FieldCloudCover,
+	FieldVisibility,
+	FieldUV,
+	FieldRadiation,
+	FieldAirQuality,
+	FieldAlerts,
+	FieldInsights,
+}
+
+// FieldSet is a set of selected subtrees; a nil FieldSet selects everything
+type FieldSet map[string]bool
+
+// Has reports whether a subtree is selected
+func (fs FieldSet) Has(field string) bool {
+	return fs == nil || fs[field]
+}
+
+// ParseWeatherFields parses a comma-separated list of subtree names
+// An empty value yields a nil FieldSet (all subtrees); unknown names are rejected
+func ParseWeatherFields(value string) (FieldSet, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	known := make(map[string]bool, len(WeatherFields))
+	for _, field := range WeatherFields {
+		known[field] = true
+	}
+
+	fields := FieldSet{}
+	for _, field := range strings.Split(value, ",") {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if field == "" {
+			continue
+		}
+		if !known[field] {
+			return nil, fmt.Errorf("unknown field %q, expected one of: %s", field, strings.Join(WeatherFields, ", "))
+		}
+		fields[field] = true
+	}
+
+	return fields, nil
+}
+
+// WeatherDetailResponse is the complete, versioned HTTP response DTO for weather endpoints
+// Every subtree is optional so clients can request only what they need
+type WeatherDetailResponse struct {
+	SchemaVersion int                  `json:"schema_version"`
+	LastUpdated   string               `json:"last_updated,omitempty"`
+	Stale         bool                 `json:"stale"`
+	Units         UnitsResponse        `json:"units"`
+	Location      *LocationResponse    `json:"location,omitempty"`
+	Temperature   *TemperatureResponse `json:"temperature,omitempty"`
+	Condition     *ConditionResponse   `json:"condition,omitempty"`
+	Wind          *WindResponse        `json:"wind,omitempty"`
+	Pressure      *float64             `json:"pressure,omitempty"`
+	Precipitation *float64             `json:"precipitation,omitempty"`
+	Humidity      *int                 `json:"humidity,omitempty"`
+	CloudCover    *int                 `json:"cloud_cover,omitempty"`
+	Visibility    *float64             `json:"visibility,omitempty"`
+	UV            *UVResponse          `json:"uv,omitempty"`
+	Radiation     *RadiationResponse   `json:"radiation,omitempty"`
+	AirQuality    *AirQualityResponse  `json:"air_quality,omitempty"`
+	Alerts        *[]AlertResponse     `json:"alerts,omitempty"`
+	Insights      *InsightsResponse    `json:"insights,omitempty"`
+}
+
+// LocationResponse describes where the weather was observed
+type LocationResponse struct {
+	Name      string  `json:"name"`
+	Region    string  `json:"region"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lon"`
+	Timezone  string  `json:"timezone"`
+	LocalTime string  `json:"local_time,omitempty"`
+}
+
+// TemperatureResponse holds the measured and derived temperatures
+type TemperatureResponse struct {
+	Value     float64 `json:"value"`
+	FeelsLike float64 `json:"feels_like"`
+	Windchill float64 `json:"windchill"`
+	HeatIndex float64 `json:"heat_index"`
+	Dewpoint  float64 `json:"dewpoint"`
+}
+
+// ConditionResponse describes the weather phenomenon
+type ConditionResponse struct {
+	Text  string `json:"text"`
+	Code  int    `json:"code"`
+	Icon  string `json:"icon"`
+	IsDay bool   `json:"is_day"`
+}
+
+// WindResponse holds wind measurements
+type WindResponse struct {
+	Speed     float64 `json:"speed"`
+	Gust      float64 `json:"gust"`
+	Degree    int     `json:"degree"`
+	Direction string  `json:"direction"`
+}
+
+// UVResponse holds the UV index
+type UVResponse struct {
+	Index float64 `json:"index"`
+}
+
+// RadiationResponse holds solar radiation measurements in W/m2
+type RadiationResponse struct {
+	ShortWave float64 `json:"short_wave"`
+	Diffuse   float64 `json:"diffuse"`
+	DNI       float64 `json:"dni"`
+	GTI       float64 `json:"gti"`
+}
+
+// InsightsResponse holds the domain's classifications of the current conditions
+// Clients should rely on these instead of reimplementing the thresholds
+type InsightsResponse struct {
+	Description         string `json:"description"`
+	ComfortLevel        string `json:"comfort_level"`
+	BeaufortScale       int    `json:"beaufort_scale"`
+	BeaufortDescription string `json:"beaufort_description"`
+	RainfallIntensity   string `json:"rainfall_intensity"`
+	UVRisk              string `json:"uv_risk"`
+	IsPoorVisibility    bool   `json:"is_poor_visibility"`
+	IsExtreme           bool   `json:"is_extreme"`
+}
+
+// InsightsFromDomain maps the domain classifications of weather data to their HTTP response DTO
+func InsightsFromDomain(w *weather.Weather) InsightsResponse {
+	current := w.Current
+	return InsightsResponse{
+		Description:         w.GetFullDescription(),
+		ComfortLevel:        current.Temperature.GetComfortLevel(),
+		BeaufortScale:       current.Wind.GetBeaufortScale(),
+		BeaufortDescription: current.Wind.GetBeaufortDescription(),
+		RainfallIntensity:   current.Precipitation.GetRainfallIntensity(),
+		UVRisk:              current.GetUVRisk(),
+		IsPoorVisibility:    current.IsPoorVisibility(),
+		IsExtreme:           w.IsExtreme(),
+	}
+}
+
+// WeatherDetailFromDomain maps domain weather data to the complete response DTO,
+// keeping only the selected subtrees and expressing measurements in the requested unit system
+func WeatherDetailFromDomain(w *weather.Weather, fields FieldSet, units weather.UnitSystem) WeatherDetailResponse {
+	current := w.Current
+	response := WeatherDetailResponse{
+		SchemaVersion: WeatherDetailSchemaVersion,
+		LastUpdated:   formatTime(current.LastUpdated),
+		Stale:         w.Stale,
+		Units:         UnitsFromDomain(units),
+	}
+
+	if fields.Has(FieldLocation) {
+		response.Location = &LocationResponse{
+			Name:      w.Location.Name,
+			Region:    w.Location.Region,
+			Country:   w.Location.Country,
+			Latitude:  w.Location.Latitude,
+			Longitude: w.Location.Longitude,
+			Timezone:  w.Location.Timezone,
+			LocalTime: formatTime(w.Location.LocalTime),
+		}
+	}
+	if fields.Has(FieldTemperature) {
+		t := current.Temperature
+		response.Temperature = &TemperatureResponse{
+			Value:     units.Temperature(t.Celsius),
+			FeelsLike: units.Temperature(t.FeelsLike.Celsius),
+			Windchill: units.Temperature(t.Windchill.Celsius),
+			HeatIndex: units.Temperature(t.HeatIndex.Celsius),
+			Dewpoint:  units.Temperature(t.Dewpoint.Celsius),
+		}
+	}
+	if fields.Has(FieldCondition) {
+		response.Condition = &ConditionResponse{
+			Text:  current.Condition.Text,
+			Code:  current.Condition.Code,
+			Icon:  current.Condition.Icon,
+			IsDay: current.IsDay,
+		}
+	}
+	if fields.Has(FieldWind) {
+		response.Wind = &WindResponse{
+			Speed:     units.Speed(current.Wind.SpeedKph),
+			Gust:      units.Speed(current.Wind.GustKph),
+			Degree:    current.Wind.Degree,
+			Direction: current.Wind.Direction,
+		}
+	}
+	if fields.Has(FieldPressure) {
+		pressure := units.Pressure(current.Pressure.Millibars)
+		response.Pressure = &pressure
+	}
+	if fields.Has(FieldPrecipitation) {
+		precipitation := units.Precipitation(current.Precipitation.Millimeters)
+		response.Precipitation = &precipitation
+	}
+	if fields.Has(FieldHumidity) {
+		humidity := current.Humidity
+		response.Humidity = &humidity
+	}
+	if fields.Has(FieldCloudCover) {
+		cloudCover := current.CloudCover
+		response.CloudCover = &cloudCover
+	}
+	if fields.Has(FieldVisibility) {
+		visibility := units.Distance(current.Visibility.Kilometers)
+		response.Visibility = &visibility
+	}
+	if fields.Has(FieldUV) {
+		response.UV = &UVResponse{
+			Index: current.UVIndex,
+		}
+	}
+	if fields.Has(FieldRadiation) {
+		response.Radiation = &RadiationResponse{
+			ShortWave: current.Radiation.ShortWave,
+			Diffuse:   current.Radiation.Diffuse,
+			DNI:       current.Radiation.DNI,
+			GTI:       current.Radiation.GTI,
+		}
+	}
+	if fields.Has(FieldAirQuality) {
+		// Omitted when the provider reported no air quality data
+		response.AirQuality = AirQualityFromDomain(current.AirQuality)
+	}
+	if fields.Has(FieldAlerts) {
+		alerts := alertListFromDomain(w.Alerts)
+		response.Alerts = &alerts
+	}
+	if fields.Has(FieldInsights) {
+		insights := InsightsFromDomain(w)
+		response.Insights = &insights
+	}
+
+	return response
+}
@@ -4,18 +4,65 @@ import "weather-api-wrapper/internal/domain/weather"
 
 // WeatherResponse is the HTTP response DTO for weather endpoints
 // It provides a simplified view of weather data for API clients
+// temperature_c is always in Celsius for backward compatibility; temperature follows the requested unit system
+// stale is true when the data is older than its freshness lifetime
 type WeatherResponse struct {
-	Location    string  `json:"location"`
-	Temperature float64 `json:"temperature_c"`
-	Condition   string  `json:"condition_text"`
+	Location             string              `json:"location"`
+	Temperature          float64             `json:"temperature_c"`
+	ConvertedTemperature float64             `json:"temperature"`
+	Units                UnitsResponse       `json:"units"`
+	Condition            string              `json:"condition_text"`
+	Stale                bool                `json:"stale"`
+	AirQuality           *AirQualityResponse `json:"air_quality,omitempty"`
+}
+
+// AirQualityResponse is the air quality section of weather responses
+// Concentrations are expressed in μg/m3
+type AirQualityResponse struct {
+	CO            float64 `json:"co"`
+	NO2           float64 `json:"no2"`
+	O3            float64 `json:"o3"`
+	SO2           float64 `json:"so2"`
+	PM2_5         float64 `json:"pm2_5"`
+	PM10          float64 `json:"pm10"`
+	USEPAIndex    int     `json:"us_epa_index"`
+	USEPACategory string  `json:"us_epa_category"`
+	UKDEFRAIndex  int     `json:"uk_defra_index"`
+	UKDEFRABand   string  `json:"uk_defra_band"`
+	HealthAdvice  string  `json:"health_advice"`
 }
 
 // FromDomain maps domain weather data to HTTP response DTO
 // This keeps the HTTP layer decoupled from domain structure
-func FromDomain(w *weather.Weather) WeatherResponse {
+func FromDomain(w *weather.Weather, units weather.UnitSystem) WeatherResponse {
 	return WeatherResponse{
-		Location:    w.Location.Name,
-		Temperature: w.Current.Temperature.Celsius,
-		Condition:   w.Current.Condition.Text,
+		Location:             w.Location.Name,
+		Temperature:          w.Current.Temperature.Celsius,
+		ConvertedTemperature: units.Temperature(w.Current.Temperature.Celsius),
+		Units:                UnitsFromDomain(units),
+		Condition:            w.Current.Condition.Text,
+		Stale:                w.Stale,
+	}
+}
+
+// AirQualityFromDomain maps domain air quality data to its HTTP response DTO
+// It returns nil when no air quality data is available
+func AirQualityFromDomain(aq *weather.AirQuality) *AirQualityResponse {
+	if aq == nil {
+		return nil
+	}
+
+	return &AirQualityResponse{
+		CO:            aq.CarbonMonoxide,
+		NO2:           aq.NitrogenDioxide,
+		O3:            aq.Ozone,
+		SO2:           aq.SulphurDioxide,
+		PM2_5:         aq.PM2_5,
+		PM10:          aq.PM10,
+		USEPAIndex:    aq.USEPAIndex,
+		USEPACategory: aq.GetUSEPACategory(),
+		UKDEFRAIndex:  aq.UKDEFRAIndex,
+		UKDEFRABand:   aq.GetUKDEFRABand(),
+		HealthAdvice:  aq.GetHealthAdvice(),
 	}
 }
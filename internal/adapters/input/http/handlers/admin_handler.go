@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// AdminHandler handles HTTP requests for cache administration
+// Its routes must be protected by authentication.
+type AdminHandler struct {
+	cacheAdminUseCase input.CacheAdminUseCase
+}
+
+// NewAdminHandler creates a new cache administration HTTP handler
+func NewAdminHandler(useCase input.CacheAdminUseCase) *AdminHandler {
+	return &AdminHandler{
+		cacheAdminUseCase: useCase,
+	}
+}
+
+// ListCacheHandler handles GET /admin/cache requests
+// It lists the cached current weather entries, optionally filtered by a key prefix after "current:"
+func (h *AdminHandler) ListCacheHandler(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+
+	cached, err := h.cacheAdminUseCase.ListCachedWeather(r.Context(), prefix)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.CacheListFromDomain(prefix, cached, time.Now()))
+}
+
+// GetCacheEntryHandler handles GET /admin/cache/entry requests
+// It returns the entry stored under the key query parameter, with its raw value
+func (h *AdminHandler) GetCacheEntryHandler(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		http.Error(w, "key query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	cached, err := h.cacheAdminUseCase.GetCacheEntry(r.Context(), key)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.CacheEntryDetailFromDomain(cached, time.Now()))
+}
+
+// InvalidateLocationHandler handles DELETE /admin/cache/location requests
+// The location is given with the same query parameters as the weather endpoints
+func (h *AdminHandler) InvalidateLocationHandler(w http.ResponseWriter, r *http.Request) {
+	location, ok := parseLocationQuery(w, r)
+	if !ok {
+		return
+	}
+
+	deleted, err := h.cacheAdminUseCase.InvalidateLocation(r.Context(), location)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.CacheInvalidationResponse{Deleted: deleted})
+}
+
+// InvalidatePrefixHandler handles DELETE /admin/cache requests
+// It removes the entries whose keys start with the required prefix query parameter
+func (h *AdminHandler) InvalidatePrefixHandler(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+	if prefix == "" {
+		http.Error(w, "prefix query parameter is required; use POST /admin/cache/flush to remove everything", http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := h.cacheAdminUseCase.InvalidatePrefix(r.Context(), prefix)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.CacheInvalidationResponse{Deleted: deleted})
+}
+
+// FlushCacheHandler handles POST /admin/cache/flush requests
+// It removes every entry in the service's cache namespace
+func (h *AdminHandler) FlushCacheHandler(w http.ResponseWriter, r *http.Request) {
+	deleted, err := h.cacheAdminUseCase.FlushCache(r.Context())
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.CacheInvalidationResponse{Deleted: deleted})
+}
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockCacheAdminUseCase mocks the CacheAdminUseCase input port
+type MockCacheAdminUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCacheAdminUseCase) ListCachedWeather(ctx context.Context, prefix string) ([]weather.CachedWeather, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]weather.CachedWeather), args.Error(1)
+}
+
+func (m *MockCacheAdminUseCase) GetCacheEntry(ctx context.Context, key string) (*weather.CachedWeather, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.CachedWeather), args.Error(1)
+}
+
+func (m *MockCacheAdminUseCase) InvalidateLocation(ctx context.Context, query weather.LocationQuery) (int, error) {
+	args := m.Called(ctx, query)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCacheAdminUseCase) InvalidatePrefix(ctx context.Context, prefix string) (int, error) {
+	args := m.Called(ctx, prefix)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCacheAdminUseCase) FlushCache(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func TestListCacheHandler(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache?prefix=lon", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("ListCachedWeather", mock.Anything, "lon").Return([]weather.CachedWeather{
+		{
+			CacheEntry: weather.CacheEntry{Key: "current:london", AliasOf: "current:51.52,-0.11", TTL: 24 * time.Hour},
+			Value: &weather.Weather{
+				Location:  weather.Location{Name: "London"},
+				UpdatedAt: time.Now().Add(-10 * time.Minute),
+			},
+		},
+		{CacheEntry: weather.CacheEntry{Key: "current:long beach", TTL: time.Hour}},
+	}, nil)
+
+	// Act
+	handler.ListCacheHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.CacheListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "lon", response.Prefix)
+	assert.Equal(t, 2, response.Count)
+
+	london := response.Entries[0]
+	assert.Equal(t, "current:51.52,-0.11", london.AliasOf)
+	assert.Equal(t, "London", london.Location)
+	assert.Equal(t, int64(86400), london.TTLSeconds)
+	require.NotNil(t, london.AgeSeconds)
+	assert.InDelta(t, 600, *london.AgeSeconds, 1)
+
+	assert.Nil(t, response.Entries[1].AgeSeconds)
+}
+
+func TestListCacheHandler_CacheUnavailable(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("ListCachedWeather", mock.Anything, "").
+		Return(nil, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, errors.New("connection refused")))
+
+	// Act
+	handler.ListCacheHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestGetCacheEntryHandler(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache/entry?key=current:london", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("GetCacheEntry", mock.Anything, "current:london").Return(&weather.CachedWeather{
+		CacheEntry: weather.CacheEntry{Key: "current:london", TTL: time.Hour},
+		Value:      &weather.Weather{Location: weather.Location{Name: "London"}, UpdatedAt: time.Now()},
+	}, nil)
+
+	// Act
+	handler.GetCacheEntryHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "current:london", response["key"])
+	assert.Equal(t, float64(3600), response["ttl_seconds"])
+	value, ok := response["value"].(map[string]any)
+	require.True(t, ok, "the value is included")
+	assert.Equal(t, "London", value["location"].(map[string]any)["name"])
+	assert.NotContains(t, value, "Location", "the domain struct is not exposed")
+}
+
+func TestGetCacheEntryHandler_MissingKey(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache/entry", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetCacheEntryHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "key query parameter is required")
+	useCase.AssertNotCalled(t, "GetCacheEntry", mock.Anything, mock.Anything)
+}
+
+func TestGetCacheEntryHandler_NotFound(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache/entry?key=current:atlantis", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("GetCacheEntry", mock.Anything, "current:atlantis").Return(nil, weather.ErrCacheEntryNotFound)
+
+	// Act
+	handler.GetCacheEntryHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestInvalidateLocationHandler(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/cache/location?city=London", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("InvalidateLocation", mock.Anything, weather.LocationQuery{Kind: weather.LocationByName, Name: "London"}).Return(3, nil)
+
+	// Act
+	handler.InvalidateLocationHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"deleted": 3}`, rec.Body.String())
+}
+
+func TestInvalidatePrefixHandler_MissingPrefix(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/cache", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.InvalidatePrefixHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	useCase.AssertNotCalled(t, "InvalidatePrefix", mock.Anything, mock.Anything)
+}
+
+func TestInvalidatePrefixHandler(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/cache?prefix=forecast:", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("InvalidatePrefix", mock.Anything, "forecast:").Return(12, nil)
+
+	// Act
+	handler.InvalidatePrefixHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"deleted": 12}`, rec.Body.String())
+}
+
+func TestFlushCacheHandler(t *testing.T) {
+	// Arrange
+	useCase := new(MockCacheAdminUseCase)
+	handler := NewAdminHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/cache/flush", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("FlushCache", mock.Anything).Return(42, nil)
+
+	// Act
+	handler.FlushCacheHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"deleted": 42}`, rec.Body.String())
+}
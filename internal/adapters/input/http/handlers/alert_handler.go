@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// AlertHandler handles HTTP requests for severe weather alerts
+type AlertHandler struct {
+	alertsUseCase input.GetAlertsUseCase
+}
+
+// NewAlertHandler creates a new alerts HTTP handler
+func NewAlertHandler(useCase input.GetAlertsUseCase) *AlertHandler {
+	return &AlertHandler{
+		alertsUseCase: useCase,
+	}
+}
+
+// GetAlertsHandler handles GET /alerts requests
+func (h *AlertHandler) GetAlertsHandler(w http.ResponseWriter, r *http.Request) {
+	// Resolve the requested location
+	location, ok := parseLocationQuery(w, r)
+	if !ok {
+		return
+	}
+
+	// Call use case
+	alerts, err := h.alertsUseCase.GetAlerts(r.Context(), location)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Convert domain model to DTO and send JSON response
+	writeJSON(w, dto.AlertsFromDomain(alerts))
+}
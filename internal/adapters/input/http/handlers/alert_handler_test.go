@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockGetAlertsUseCase mocks the GetAlertsUseCase input port
+type MockGetAlertsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetAlertsUseCase) GetAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.WeatherAlerts), args.Error(1)
+}
+
+func TestGetAlertsHandler_MissingCity(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAlertsUseCase)
+	handler := NewAlertHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/alerts", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetAlertsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "city query parameter is required")
+
+	useCase.AssertNotCalled(t, "GetAlerts", mock.Anything, mock.Anything)
+}
+
+func TestGetAlertsHandler_Success(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAlertsUseCase)
+	handler := NewAlertHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/alerts?city=Miami", nil)
+	rec := httptest.NewRecorder()
+
+	alerts := &weather.WeatherAlerts{
+		Location: weather.Location{Name: "Miami"},
+		Alerts: []weather.Alert{
+			{
+				Headline:  "Hurricane Warning issued March 2 at 5:00AM EST",
+				Event:     "Hurricane Warning",
+				Severity:  "Extreme",
+				Urgency:   "Immediate",
+				Areas:     []string{"Miami-Dade", "Broward"},
+				Effective: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+				Expires:   time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	useCase.
+		On("GetAlerts", ctx, nameQuery("Miami")).
+		Return(alerts, nil).
+		Once()
+
+	// Act
+	handler.GetAlertsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response dto.AlertsResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Miami", response.Location)
+	require.Len(t, response.Alerts, 1)
+	assert.Equal(t, "Hurricane Warning", response.Alerts[0].Event)
+	assert.Equal(t, "Extreme", response.Alerts[0].Severity)
+	assert.Equal(t, []string{"Miami-Dade", "Broward"}, response.Alerts[0].Areas)
+	assert.Equal(t, "2026-03-02T10:00:00Z", response.Alerts[0].Effective)
+	assert.Equal(t, "2026-03-03T10:00:00Z", response.Alerts[0].Expires)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetAlertsHandler_NoAlerts(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAlertsUseCase)
+	handler := NewAlertHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/alerts?city=Athens", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetAlerts", ctx, nameQuery("Athens")).
+		Return(&weather.WeatherAlerts{Location: weather.Location{Name: "Athens"}}, nil).
+		Once()
+
+	// Act
+	handler.GetAlertsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// An empty list rather than null
+	assert.Contains(t, rec.Body.String(), `"alerts":[]`)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetAlertsHandler_WeatherUnavailable(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAlertsUseCase)
+	handler := NewAlertHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/alerts?city=Miami", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetAlerts", ctx, nameQuery("Miami")).
+		Return(nil, weather.ErrWeatherUnavailable).
+		Once()
+
+	// Act
+	handler.GetAlertsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	useCase.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// AstronomyHandler handles HTTP requests for astronomy data
+type AstronomyHandler struct {
+	astronomyUseCase input.GetAstronomyUseCase
+}
+
+// NewAstronomyHandler creates a new astronomy HTTP handler
+func NewAstronomyHandler(useCase input.GetAstronomyUseCase) *AstronomyHandler {
+	return &AstronomyHandler{
+		astronomyUseCase: useCase,
+	}
+}
+
+// GetAstronomyHandler handles GET /astronomy requests
+// The date query parameter is optional and defaults to today (UTC)
+func (h *AstronomyHandler) GetAstronomyHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Resolve the requested location
+	location, ok := parseLocationQuery(w, r)
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if rawDate := query.Get("date"); rawDate != "" {
+		parsed, err := weather.ParseDate(rawDate)
+		if err != nil {
+			handleError(w, err)
+			return
+		}
+		date = parsed
+	}
+
+	// Call use case
+	report, err := h.astronomyUseCase.GetAstronomy(r.Context(), location, date)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Convert domain model to DTO and send JSON response
+	writeJSON(w, dto.AstronomyReportFromDomain(report))
+}
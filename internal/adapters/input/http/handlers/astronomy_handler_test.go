@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockGetAstronomyUseCase mocks the GetAstronomyUseCase input port
+type MockGetAstronomyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetAstronomyUseCase) GetAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error) {
+	args := m.Called(ctx, query, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.AstronomyReport), args.Error(1)
+}
+
+func TestGetAstronomyHandler_MissingCity(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAstronomyUseCase)
+	handler := NewAstronomyHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/astronomy?date=2026-06-21", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetAstronomyHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "city query parameter is required")
+
+	useCase.AssertNotCalled(t, "GetAstronomy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetAstronomyHandler_InvalidDate(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAstronomyUseCase)
+	handler := NewAstronomyHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/astronomy?city=London&date=21-06-2026", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetAstronomyHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid date")
+
+	useCase.AssertNotCalled(t, "GetAstronomy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetAstronomyHandler_DefaultsToToday(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAstronomyUseCase)
+	handler := NewAstronomyHandler(useCase)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/astronomy?city=London", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetAstronomy", ctx, nameQuery("London"), today).
+		Return(&weather.AstronomyReport{Location: weather.Location{Name: "London"}, Date: today}, nil).
+		Once()
+
+	// Act
+	handler.GetAstronomyHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetAstronomyHandler_Success(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAstronomyUseCase)
+	handler := NewAstronomyHandler(useCase)
+	ctx := context.Background()
+
+	date := time.Date(2026, 6, 21, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/astronomy?city=London&date=2026-06-21", nil)
+	rec := httptest.NewRecorder()
+
+	report := &weather.AstronomyReport{
+		Location: weather.Location{Name: "London"},
+		Date:     date,
+		Astronomy: weather.Astronomy{
+			Sunrise:          time.Date(2026, 6, 21, 3, 43, 0, 0, time.UTC),
+			Sunset:           time.Date(2026, 6, 21, 20, 21, 0, 0, time.UTC),
+			Moonrise:         time.Date(2026, 6, 21, 12, 30, 0, 0, time.UTC),
+			MoonPhase:        "First Quarter",
+			MoonIllumination: 48,
+		},
+	}
+
+	useCase.
+		On("GetAstronomy", ctx, nameQuery("London"), date).
+		Return(report, nil).
+		Once()
+
+	// Act
+	handler.GetAstronomyHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response dto.AstronomyReportResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+	require.NoError(t, err)
+	assert.Equal(t, "London", response.Location)
+	assert.Equal(t, "2026-06-21", response.Date)
+	assert.Equal(t, "2026-06-21T03:43:00Z", response.Sunrise)
+	assert.Equal(t, "2026-06-21T20:21:00Z", response.Sunset)
+	assert.Empty(t, response.Moonset)
+	assert.Equal(t, "First Quarter", response.MoonPhase)
+	assert.Equal(t, 48, response.MoonIllumination)
+	assert.Equal(t, 16*60+38, response.DaylightMinutes)
+	require.NotNil(t, response.GoldenHour)
+	assert.Equal(t, "2026-06-21T04:43:00Z", response.GoldenHour.Morning.End)
+	assert.Equal(t, "2026-06-21T19:21:00Z", response.GoldenHour.Evening.Start)
+	assert.False(t, response.Estimated)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetAstronomyHandler_WeatherUnavailable(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetAstronomyUseCase)
+	handler := NewAstronomyHandler(useCase)
+	ctx := context.Background()
+
+	date := time.Date(2026, 6, 21, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/astronomy?city=London&date=2026-06-21", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetAstronomy", ctx, nameQuery("London"), date).
+		Return(nil, weather.ErrWeatherUnavailable).
+		Once()
+
+	// Act
+	handler.GetAstronomyHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	useCase.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// defaultForecastDays is used when the days query parameter is omitted
+const defaultForecastDays = 3
+
+// ForecastHandler handles HTTP requests for forecast data
+type ForecastHandler struct {
+	forecastUseCase input.GetForecastUseCase
+	options
+}
+
+// NewForecastHandler creates a new forecast HTTP handler
+func NewForecastHandler(useCase input.GetForecastUseCase, opts ...Option) *ForecastHandler {
+	return &ForecastHandler{
+		forecastUseCase: useCase,
+		options:         newOptions(opts),
+	}
+}
+
+// GetForecastHandler handles GET /forecast requests
+func (h *ForecastHandler) GetForecastHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Resolve the requested location
+	location, ok := parseLocationQuery(w, r)
+	if !ok {
+		return
+	}
+
+	days := defaultForecastDays
+	if rawDays := query.Get("days"); rawDays != "" {
+		parsed, err := strconv.Atoi(rawDays)
+		if err != nil {
+			http.Error(w, "days query parameter must be an integer", http.StatusBadRequest)
+			return
+		}
+		days = parsed
+	}
+
+	units, err := h.resolveUnits(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Call use case
+	forecast, err := h.forecastUseCase.GetForecast(r.Context(), location, days)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Convert domain model to DTO and send JSON response
+	writeJSON(w, dto.ForecastFromDomain(forecast, units))
+}
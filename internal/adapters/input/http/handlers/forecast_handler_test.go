@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockGetForecastUseCase mocks the GetForecastUseCase input port
+type MockGetForecastUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetForecastUseCase) GetForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error) {
+	args := m.Called(ctx, query, days)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Forecast), args.Error(1)
+}
+
+func createSampleDomainForecast() *weather.Forecast {
+	athens := time.FixedZone("EET", 2*60*60)
+	date := time.Date(2026, 3, 2, 0, 0, 0, 0, athens)
+
+	return &weather.Forecast{
+		Location: weather.Location{
+			Name:    "Athens",
+			Country: "Greece",
+		},
+		Days: []weather.DailyWeather{
+			{
+				Date:         date,
+				MaxTemp:      weather.TemperatureValue{Celsius: 18.2},
+				MinTemp:      weather.TemperatureValue{Celsius: 9.1},
+				MaxWind:      weather.Wind{SpeedKph: 36},
+				TotalPrecip:  weather.Precipitation{Millimeters: 12.7},
+				ChanceOfRain: 80,
+				Condition:    weather.Condition{Text: "Patchy rain nearby"},
+				Astronomy: weather.Astronomy{
+					Sunrise:   time.Date(2026, 3, 2, 6, 52, 0, 0, athens),
+					Sunset:    time.Date(2026, 3, 2, 18, 26, 0, 0, athens),
+					MoonPhase: "Waxing Gibbous",
+				},
+				Hours: []weather.HourlyWeather{
+					{
+						Time:        date,
+						Temperature: weather.Temperature{Celsius: 10.5},
+						Condition:   weather.Condition{Text: "Clear"},
+					},
+				},
+			},
+		},
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestGetForecastHandler_MissingCity(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "city query parameter is required")
+
+	useCase.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetForecastHandler_NonNumericDays(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast?city=Athens&days=three", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "days query parameter must be an integer")
+
+	useCase.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetForecastHandler_InvalidDays(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast?city=Athens&days=30", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetForecast", ctx, nameQuery("Athens"), 30).
+		Return(nil, weather.ErrInvalidForecastDays).
+		Once()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid forecast days")
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetForecastHandler_DefaultDays(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast?city=Athens", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetForecast", ctx, nameQuery("Athens"), defaultForecastDays).
+		Return(createSampleDomainForecast(), nil).
+		Once()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetForecastHandler_Success(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast?city=Athens&days=1", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetForecast", ctx, nameQuery("Athens"), 1).
+		Return(createSampleDomainForecast(), nil).
+		Once()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response dto.ForecastResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Athens", response.Location)
+	require.Len(t, response.Days, 1)
+
+	day := response.Days[0]
+	assert.Equal(t, "2026-03-02", day.Date)
+	assert.Equal(t, 18.2, day.MaxTemperature)
+	assert.Equal(t, 9.1, day.MinTemperature)
+	assert.Equal(t, 80, day.ChanceOfRain)
+	assert.Equal(t, "2026-03-02T06:52:00+02:00", day.Astronomy.Sunrise)
+	assert.Empty(t, day.Astronomy.Moonrise)
+	require.Len(t, day.Hours, 1)
+	assert.Equal(t, 10.5, day.Hours[0].Temperature)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetForecastHandler_ImperialUnits(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast?city=Athens&days=1&units=imperial", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetForecast", ctx, nameQuery("Athens"), 1).
+		Return(createSampleDomainForecast(), nil).
+		Once()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.ForecastResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "imperial", response.Units.System)
+	assert.Equal(t, "mph", response.Units.Speed)
+	require.Len(t, response.Days, 1)
+
+	day := response.Days[0]
+	assert.Equal(t, 64.76, day.MaxTemperature)
+	assert.Equal(t, 22.37, day.MaxWind)
+	assert.Equal(t, 0.5, day.TotalPrecipitation)
+	require.Len(t, day.Hours, 1)
+	assert.Equal(t, 50.9, day.Hours[0].Temperature)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetForecastHandler_WeatherUnavailable(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetForecastUseCase)
+	handler := NewForecastHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/forecast?city=Athens&days=3", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetForecast", ctx, nameQuery("Athens"), 3).
+		Return(nil, weather.ErrWeatherUnavailable).
+		Once()
+
+	// Act
+	handler.GetForecastHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	useCase.AssertExpectations(t)
+}
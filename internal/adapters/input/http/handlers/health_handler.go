@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// HealthHandler handles HTTP requests for the service health
+type HealthHandler struct {
+	healthCheckUseCase input.HealthCheckUseCase
+}
+
+// NewHealthHandler creates a new health HTTP handler
+func NewHealthHandler(useCase input.HealthCheckUseCase) *HealthHandler {
+	return &HealthHandler{
+		healthCheckUseCase: useCase,
+	}
+}
+
+// GetHealthHandler handles GET /health requests
+// It responds 200 even while an upstream provider is unavailable, since cached
+// data is still served; the status field tells the two apart.
+func (h *HealthHandler) GetHealthHandler(w http.ResponseWriter, r *http.Request) {
+	report, err := h.healthCheckUseCase.CheckHealth(r.Context())
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.HealthFromDomain(report))
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockHealthCheckUseCase mocks the HealthCheckUseCase input port
+type MockHealthCheckUseCase struct {
+	mock.Mock
+}
+
+func (m *MockHealthCheckUseCase) CheckHealth(ctx context.Context) (*weather.HealthReport, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.HealthReport), args.Error(1)
+}
+
+func TestGetHealthHandler(t *testing.T) {
+	since := time.Date(2026, 2, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		state          weather.CircuitState
+		expectedStatus string
+	}{
+		{name: "Closed", state: weather.CircuitClosed, expectedStatus: dto.HealthStatusOK},
+		{name: "Half-open", state: weather.CircuitHalfOpen, expectedStatus: dto.HealthStatusOK},
+		{name: "Open", state: weather.CircuitOpen, expectedStatus: dto.HealthStatusDegraded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useCase := new(MockHealthCheckUseCase)
+			handler := NewHealthHandler(useCase)
+
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+			rec := httptest.NewRecorder()
+
+			useCase.On("CheckHealth", mock.Anything).Return(&weather.HealthReport{
+				Upstreams: []weather.UpstreamHealth{
+					{Name: "weatherapi.com", State: tt.state, Since: since, Requests: 12, Failures: 7},
+				},
+			}, nil)
+
+			// Act
+			handler.GetHealthHandler(rec, req)
+
+			// Assert
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var response dto.HealthResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedStatus, response.Status)
+			assert.Equal(t, []dto.UpstreamHealthResponse{
+				{Name: "weatherapi.com", State: string(tt.state), Since: since, Requests: 12, Failures: 7},
+			}, response.Upstreams)
+		})
+	}
+}
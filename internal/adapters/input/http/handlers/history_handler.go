@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// HistoryHandler handles HTTP requests for historical weather data
+type HistoryHandler struct {
+	historyUseCase input.GetHistoryUseCase
+	options
+}
+
+// NewHistoryHandler creates a new history HTTP handler
+func NewHistoryHandler(useCase input.GetHistoryUseCase, opts ...Option) *HistoryHandler {
+	return &HistoryHandler{
+		historyUseCase: useCase,
+		options:        newOptions(opts),
+	}
+}
+
+// GetHistoryHandler handles GET /history requests
+// It accepts either a single date (date=YYYY-MM-DD) or an inclusive range (from=...&to=...)
+func (h *HistoryHandler) GetHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Resolve the requested location
+	location, ok := parseLocationQuery(w, r)
+	if !ok {
+		return
+	}
+
+	rawDate, rawFrom, rawTo := query.Get("date"), query.Get("from"), query.Get("to")
+
+	var rawStart, rawEnd string
+	switch {
+	case rawDate != "" && (rawFrom != "" || rawTo != ""):
+		http.Error(w, "date cannot be combined with from/to query parameters", http.StatusBadRequest)
+		return
+	case rawDate != "":
+		rawStart, rawEnd = rawDate, rawDate
+	case rawFrom != "" && rawTo != "":
+		rawStart, rawEnd = rawFrom, rawTo
+	default:
+		http.Error(w, "either date or both from and to query parameters are required", http.StatusBadRequest)
+		return
+	}
+
+	from, err := weather.ParseDate(rawStart)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	to, err := weather.ParseDate(rawEnd)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	units, err := h.resolveUnits(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Call use case
+	history, err := h.historyUseCase.GetHistory(r.Context(), location, from, to)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Convert domain model to DTO and send JSON response
+	writeJSON(w, dto.HistoryFromDomain(history, units))
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockGetHistoryUseCase mocks the GetHistoryUseCase input port
+type MockGetHistoryUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetHistoryUseCase) GetHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error) {
+	args := m.Called(ctx, query, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.History), args.Error(1)
+}
+
+func createSampleDomainHistory(dates ...time.Time) *weather.History {
+	history := &weather.History{
+		Location: weather.Location{
+			Name:    "Athens",
+			Country: "Greece",
+		},
+		UpdatedAt: time.Now(),
+	}
+	for _, date := range dates {
+		history.Days = append(history.Days, weather.DailyWeather{
+			Date:        date,
+			MaxTemp:     weather.TemperatureValue{Celsius: 16.4},
+			MinTemp:     weather.TemperatureValue{Celsius: 7.9},
+			TotalPrecip: weather.Precipitation{Millimeters: 4.2},
+			Condition:   weather.Condition{Text: "Moderate rain"},
+		})
+	}
+	return history
+}
+
+func TestGetHistoryHandler_MissingCity(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetHistoryUseCase)
+	handler := NewHistoryHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/history?date=2026-03-02", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetHistoryHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "city query parameter is required")
+
+	useCase.AssertNotCalled(t, "GetHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetHistoryHandler_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		expectedMessage string
+	}{
+		{
+			name:            "No date",
+			url:             "/history?city=Athens",
+			expectedMessage: "either date or both from and to query parameters are required",
+		},
+		{
+			name:            "Only from",
+			url:             "/history?city=Athens&from=2026-03-02",
+			expectedMessage: "either date or both from and to query parameters are required",
+		},
+		{
+			name:            "Date and range",
+			url:             "/history?city=Athens&date=2026-03-02&from=2026-03-01&to=2026-03-03",
+			expectedMessage: "date cannot be combined with from/to query parameters",
+		},
+		{
+			name:            "Malformed date",
+			url:             "/history?city=Athens&date=02/03/2026",
+			expectedMessage: "invalid date",
+		},
+		{
+			name:            "Malformed range end",
+			url:             "/history?city=Athens&from=2026-03-02&to=tomorrow",
+			expectedMessage: "invalid date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := new(MockGetHistoryUseCase)
+			handler := NewHistoryHandler(useCase)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+
+			handler.GetHistoryHandler(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedMessage)
+
+			useCase.AssertNotCalled(t, "GetHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetHistoryHandler_InvalidRange(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetHistoryUseCase)
+	handler := NewHistoryHandler(useCase)
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/history?city=Athens&from=2026-03-04&to=2026-03-02", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetHistory", ctx, nameQuery("Athens"), from, to).
+		Return(nil, weather.ErrInvalidDateRange).
+		Once()
+
+	// Act
+	handler.GetHistoryHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid date range")
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetHistoryHandler_SingleDate(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetHistoryUseCase)
+	handler := NewHistoryHandler(useCase)
+	ctx := context.Background()
+
+	date := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/history?city=Athens&date=2026-03-02", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetHistory", ctx, nameQuery("Athens"), date, date).
+		Return(createSampleDomainHistory(date), nil).
+		Once()
+
+	// Act
+	handler.GetHistoryHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response dto.HistoryResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Athens", response.Location)
+	require.Len(t, response.Days, 1)
+	assert.Equal(t, "2026-03-02", response.Days[0].Date)
+	assert.Equal(t, 16.4, response.Days[0].MaxTemperature)
+	assert.Equal(t, 4.2, response.Days[0].TotalPrecipitation)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetHistoryHandler_DateRange(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetHistoryUseCase)
+	handler := NewHistoryHandler(useCase)
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	req := httptest.NewRequest(http.MethodGet, "/history?city=Athens&from=2026-03-01&to=2026-03-03", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetHistory", ctx, nameQuery("Athens"), from, to).
+		Return(createSampleDomainHistory(from, from.AddDate(0, 0, 1), to), nil).
+		Once()
+
+	// Act
+	handler.GetHistoryHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.HistoryResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+
+	require.NoError(t, err)
+	assert.Len(t, response.Days, 3)
+
+	useCase.AssertExpectations(t)
+}
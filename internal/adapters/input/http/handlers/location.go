@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// autoIP is the ip query parameter value that requests the caller's own location
+const autoIP = "auto"
+
+// parseLocationQuery builds the location query from the request's query parameters
+// Exactly one of city, lat and lon, postcode, iata or ip must be given; ip=auto uses the caller's address.
+// On failure it writes a 400 response and returns false.
+func parseLocationQuery(w http.ResponseWriter, r *http.Request) (weather.LocationQuery, bool) {
+	query := r.URL.Query()
+	city, lat, lon := query.Get("city"), query.Get("lat"), query.Get("lon")
+	postcode, iata, ip := query.Get("postcode"), query.Get("iata"), query.Get("ip")
+
+	given := 0
+	for _, value := range []string{city, lat + lon, postcode, iata, ip} {
+		if value != "" {
+			given++
+		}
+	}
+
+	switch {
+	case given == 0:
+		http.Error(w, "city query parameter is required (or lat and lon, postcode, iata or ip)", http.StatusBadRequest)
+		return weather.LocationQuery{}, false
+	case given > 1:
+		http.Error(w, "only one of city, lat and lon, postcode, iata or ip query parameters may be given", http.StatusBadRequest)
+		return weather.LocationQuery{}, false
+	case (lat == "") != (lon == ""):
+		http.Error(w, "lat and lon query parameters must be given together", http.StatusBadRequest)
+		return weather.LocationQuery{}, false
+	}
+
+	var (
+		location weather.LocationQuery
+		err      error
+	)
+	switch {
+	case city != "":
+		location, err = weather.NewNameQuery(city)
+	case lat != "":
+		location, err = weather.ParseCoordinatesQuery(lat, lon)
+	case postcode != "":
+		location, err = weather.NewPostcodeQuery(postcode)
+	case iata != "":
+		location, err = weather.NewIATAQuery(iata)
+	case ip == autoIP:
+		location, err = weather.NewIPQuery(callerIP(r))
+	default:
+		location, err = weather.NewIPQuery(ip)
+	}
+	if err != nil {
+		handleError(w, err)
+		return weather.LocationQuery{}, false
+	}
+
+	return location, true
+}
+
+// callerIP returns the address of the client that sent the request
+func callerIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
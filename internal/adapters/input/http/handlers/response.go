@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// writeJSON sends a JSON response with a 200 status code
+func writeJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// parseYesNo parses an optional yes/no query parameter, as used by WeatherAPI.com
+// An empty value means "no"; ok is false for any other value
+func parseYesNo(value string) (enabled bool, ok bool) {
+	switch value {
+	case "yes":
+		return true, true
+	case "", "no":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// handleError maps domain errors to appropriate HTTP status codes
+func handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, weather.ErrInvalidLocation),
+		errors.Is(err, weather.ErrInvalidCoordinates),
+		errors.Is(err, weather.ErrInvalidPostcode),
+		errors.Is(err, weather.ErrInvalidIATACode),
+		errors.Is(err, weather.ErrInvalidIPAddress),
+		errors.Is(err, weather.ErrInvalidSearchQuery),
+		errors.Is(err, weather.ErrInvalidForecastDays),
+		errors.Is(err, weather.ErrInvalidDate),
+		errors.Is(err, weather.ErrInvalidDateRange),
+		errors.Is(err, weather.ErrInvalidUnits),
+		errors.Is(err, weather.ErrInvalidCachePrefix):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, weather.ErrWeatherNotFound),
+		errors.Is(err, weather.ErrCacheEntryNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, weather.ErrQuotaExceeded):
+		http.Error(w, "weather service quota exceeded, try again later", http.StatusServiceUnavailable)
+	case errors.Is(err, weather.ErrProviderMisconfigured):
+		// The provider rejected our credentials: an operator has to fix the configuration
+		http.Error(w, "weather service is misconfigured", http.StatusInternalServerError)
+	case errors.Is(err, weather.ErrWeatherUnavailable):
+		http.Error(w, "weather service is currently unavailable", http.StatusServiceUnavailable)
+	case errors.Is(err, weather.ErrCacheUnavailable):
+		// Only cache administration depends on the cache; weather requests bypass it
+		http.Error(w, "cache is currently unavailable", http.StatusServiceUnavailable)
+	default:
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// SearchHandler handles HTTP requests for location search
+type SearchHandler struct {
+	searchUseCase input.SearchLocationsUseCase
+}
+
+// NewSearchHandler creates a new location search HTTP handler
+func NewSearchHandler(useCase input.SearchLocationsUseCase) *SearchHandler {
+	return &SearchHandler{
+		searchUseCase: useCase,
+	}
+}
+
+// SearchLocationsHandler handles GET /locations/search requests
+// It returns the candidate locations matching a partial name, for autocomplete
+func (h *SearchHandler) SearchLocationsHandler(w http.ResponseWriter, r *http.Request) {
+	// Validate required query parameter
+	q := r.URL.Query().Get("q")
+	if q == "" {
+		http.Error(w, "q query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	// Call use case
+	search, err := h.searchUseCase.SearchLocations(r.Context(), q)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// Convert domain model to DTO and send JSON response
+	writeJSON(w, dto.LocationSearchFromDomain(search))
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockSearchLocationsUseCase mocks the SearchLocationsUseCase input port
+type MockSearchLocationsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockSearchLocationsUseCase) SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.LocationSearch), args.Error(1)
+}
+
+func TestSearchLocationsHandler_MissingQuery(t *testing.T) {
+	// Arrange
+	useCase := new(MockSearchLocationsUseCase)
+	handler := NewSearchHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/locations/search", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.SearchLocationsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "q query parameter is required")
+
+	useCase.AssertNotCalled(t, "SearchLocations", mock.Anything, mock.Anything)
+}
+
+func TestSearchLocationsHandler_QueryTooShort(t *testing.T) {
+	// Arrange
+	useCase := new(MockSearchLocationsUseCase)
+	handler := NewSearchHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/locations/search?q=S", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("SearchLocations", ctx, "S").
+		Return(nil, weather.ErrInvalidSearchQuery).
+		Once()
+
+	// Act
+	handler.SearchLocationsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid search query")
+}
+
+func TestSearchLocationsHandler_Success(t *testing.T) {
+	// Arrange
+	useCase := new(MockSearchLocationsUseCase)
+	handler := NewSearchHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/locations/search?q=Springfield", nil)
+	rec := httptest.NewRecorder()
+
+	search := &weather.LocationSearch{
+		Query: "Springfield",
+		Candidates: []weather.LocationCandidate{
+			{ID: 2640463, Name: "Springfield", Region: "Illinois", Country: "United States of America", Latitude: 39.8, Longitude: -89.64},
+			{ID: 2640489, Name: "Springfield", Region: "Missouri", Country: "United States of America", Latitude: 37.22, Longitude: -93.3},
+		},
+	}
+
+	useCase.
+		On("SearchLocations", ctx, "Springfield").
+		Return(search, nil).
+		Once()
+
+	// Act
+	handler.SearchLocationsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response dto.LocationSearchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Springfield", response.Query)
+	require.Len(t, response.Results, 2)
+	assert.Equal(t, 2640489, response.Results[1].ID)
+	assert.Equal(t, "Missouri", response.Results[1].Region)
+	assert.Equal(t, 37.22, response.Results[1].Latitude)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestSearchLocationsHandler_NoMatches(t *testing.T) {
+	// Arrange
+	useCase := new(MockSearchLocationsUseCase)
+	handler := NewSearchHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/locations/search?q=Xyzzy", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("SearchLocations", ctx, "Xyzzy").
+		Return(&weather.LocationSearch{Query: "Xyzzy"}, nil).
+		Once()
+
+	// Act
+	handler.SearchLocationsHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"query": "Xyzzy", "results": []}`, rec.Body.String())
+}
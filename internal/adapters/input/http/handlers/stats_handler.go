@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/ports/input"
+)
+
+// StatsHandler handles HTTP requests for cache statistics
+// Upstream calls are reported per location, so its routes must be protected by authentication.
+type StatsHandler struct {
+	getStatsUseCase input.GetStatsUseCase
+}
+
+// NewStatsHandler creates a new cache statistics HTTP handler
+func NewStatsHandler(useCase input.GetStatsUseCase) *StatsHandler {
+	return &StatsHandler{
+		getStatsUseCase: useCase,
+	}
+}
+
+// GetStatsHandler handles GET /admin/stats requests
+func (h *StatsHandler) GetStatsHandler(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.getStatsUseCase.GetStats(r.Context())
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, dto.StatsFromDomain(stats, time.Now()))
+}
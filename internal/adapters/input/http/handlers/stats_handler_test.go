@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/input/http/dto"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// MockGetStatsUseCase mocks the GetStatsUseCase input port
+type MockGetStatsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetStatsUseCase) GetStats(ctx context.Context) (*weather.ServiceStats, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.ServiceStats), args.Error(1)
+}
+
+func TestGetStatsHandler(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetStatsUseCase)
+	handler := NewStatsHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("GetStats", mock.Anything).Return(&weather.ServiceStats{
+		Since: time.Now().Add(-time.Hour),
+		ByDataType: map[string]weather.CacheCounters{
+			"current":  {Hits: 6, StaleServes: 1, Misses: 1, UpstreamCalls: 2},
+			"forecast": {Misses: 2, SetFailures: 1, UpstreamCalls: 2},
+		},
+		UpstreamCallsByLocation: map[string]int64{"london": 3, weather.OtherLocations: 1},
+	}, nil)
+
+	// Act
+	handler.GetStatsHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response dto.StatsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.InDelta(t, 3600, response.UptimeSeconds, 1)
+	assert.Equal(t, dto.CacheCountersResponse{
+		Hits: 6, StaleServes: 1, Misses: 1, UpstreamCalls: 2, HitRatio: 0.875,
+	}, response.DataTypes["current"])
+	assert.Equal(t, dto.CacheCountersResponse{
+		Hits: 6, StaleServes: 1, Misses: 3, SetFailures: 1, UpstreamCalls: 4, HitRatio: 0.7,
+	}, response.Total)
+	assert.Equal(t, map[string]int64{"london": 3, "other": 1}, response.UpstreamCallsByLocation)
+}
+
+func TestGetStatsHandler_NoRequestsYet(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetStatsUseCase)
+	handler := NewStatsHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.On("GetStats", mock.Anything).Return(&weather.ServiceStats{Since: time.Now()}, nil)
+
+	// Act
+	handler.GetStatsHandler(rec, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, float64(0), response["total"].(map[string]any)["hit_ratio"])
+	assert.Empty(t, response["upstream_calls_by_location"])
+}
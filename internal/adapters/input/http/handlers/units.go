@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// unitsHeader lets clients choose a unit system once instead of on every query string
+const unitsHeader = "Accept-Units"
+
+// Option configures the HTTP handlers
+type Option func(*options)
+
+// options holds settings shared by the handlers
+type options struct {
+	defaultUnits weather.UnitSystem
+}
+
+// WithDefaultUnits sets the unit system used when a request does not choose one
+func WithDefaultUnits(units weather.UnitSystem) Option {
+	return func(o *options) {
+		o.defaultUnits = units
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{defaultUnits: weather.DefaultUnitSystem}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// resolveUnits picks the unit system for a request
+// The units query parameter takes precedence over the Accept-Units header, which takes precedence over the server default
+func (o options) resolveUnits(r *http.Request) (weather.UnitSystem, error) {
+	if value := r.URL.Query().Get("units"); value != "" {
+		return weather.ParseUnitSystem(value)
+	}
+	if value := r.Header.Get(unitsHeader); value != "" {
+		return weather.ParseUnitSystem(value)
+	}
+	return o.defaultUnits, nil
+}
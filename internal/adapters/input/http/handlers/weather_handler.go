@@ -1,67 +1,104 @@
 package handlers
 
 import (
-	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"weather-api-wrapper/internal/adapters/input/http/dto"
-	"weather-api-wrapper/internal/domain/weather"
 	"weather-api-wrapper/internal/ports/input"
 )
 
 // WeatherHandler handles HTTP requests for weather data
 type WeatherHandler struct {
 	weatherUseCase input.GetWeatherUseCase
+	options
 }
 
 // NewWeatherHandler creates a new weather HTTP handler
-func NewWeatherHandler(useCase input.GetWeatherUseCase) *WeatherHandler {
+func NewWeatherHandler(useCase input.GetWeatherUseCase, opts ...Option) *WeatherHandler {
 	return &WeatherHandler{
 		weatherUseCase: useCase,
+		options:        newOptions(opts),
 	}
 }
 
 // GetWeatherHandler handles GET /weather requests
+// The compact v1 response is returned by default, and air quality data is included only when requested with aqi=yes
+// version=2 returns the complete response, and fields= restricts it to the listed subtrees
+// Measurements follow the unit system chosen with units= or the Accept-Units header
+// The Age header reports how many seconds ago the data was fetched from the provider
 func (h *WeatherHandler) GetWeatherHandler(w http.ResponseWriter, r *http.Request) {
-	// Validate required query parameter
-	city := r.URL.Query().Get("city")
-	if city == "" {
-		http.Error(w, "city query parameter is required", http.StatusBadRequest)
+	query := r.URL.Query()
+
+	// Resolve the requested location
+	location, ok := parseLocationQuery(w, r)
+	if !ok {
+		return
+	}
+
+	includeAirQuality, ok := parseYesNo(query.Get("aqi"))
+	if !ok {
+		http.Error(w, "aqi query parameter must be yes or no", http.StatusBadRequest)
+		return
+	}
+
+	detailed, err := wantsDetailedResponse(query.Get("version"), query.Has("fields"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fields, err := dto.ParseWeatherFields(query.Get("fields"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	units, err := h.resolveUnits(r)
+	if err != nil {
+		handleError(w, err)
 		return
 	}
 
 	// Call use case
-	weatherData, err := h.weatherUseCase.GetWeather(r.Context(), city)
+	weatherData, err := h.weatherUseCase.GetWeather(r.Context(), location)
 	if err != nil {
-		h.handleError(w, err)
+		handleError(w, err)
 		return
 	}
 
-	// Convert domain model to DTO
-	response := dto.FromDomain(weatherData)
+	w.Header().Set("Age", strconv.Itoa(int(weatherData.Age(time.Now()).Seconds())))
 
-	// Send JSON response
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	// Convert domain model to DTO and send JSON response
+	if detailed {
+		writeJSON(w, dto.WeatherDetailFromDomain(weatherData, fields, units))
 		return
 	}
+
+	response := dto.FromDomain(weatherData, units)
+	if includeAirQuality {
+		response.AirQuality = dto.AirQualityFromDomain(weatherData.Current.AirQuality)
+	}
+
+	writeJSON(w, response)
 }
 
-// handleError maps domain errors to appropriate HTTP status codes
-func (h *WeatherHandler) handleError(w http.ResponseWriter, err error) {
-	switch {
-	case errors.Is(err, weather.ErrInvalidLocation):
-		http.Error(w, err.Error(), http.StatusBadRequest)
-	case errors.Is(err, weather.ErrWeatherNotFound):
-		http.Error(w, err.Error(), http.StatusNotFound)
-	case errors.Is(err, weather.ErrWeatherUnavailable):
-		http.Error(w, "weather service is currently unavailable", http.StatusServiceUnavailable)
-	case errors.Is(err, weather.ErrCacheUnavailable):
-		// Cache errors shouldn't reach here, but if they do, treat as server error
-		http.Error(w, "internal server error", http.StatusInternalServerError)
+// wantsDetailedResponse resolves the response schema from the version and fields query parameters
+// Field selection is only available on the complete response, so it implies version 2
+func wantsDetailedResponse(version string, hasFields bool) (bool, error) {
+	switch version {
+	case "":
+		return hasFields, nil
+	case "1":
+		if hasFields {
+			return false, errors.New("fields query parameter requires version 2")
+		}
+		return false, nil
+	case "2":
+		return true, nil
 	default:
-		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return false, errors.New("version query parameter must be 1 or 2")
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -22,14 +23,19 @@ type MockGetWeatherUseCase struct {
 	mock.Mock
 }
 
-func (m *MockGetWeatherUseCase) GetWeather(ctx context.Context, location string) (*weather.Weather, error) {
-	args := m.Called(ctx, location)
+func (m *MockGetWeatherUseCase) GetWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error) {
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*weather.Weather), args.Error(1)
 }
 
+// nameQuery builds a location query for a place name
+func nameQuery(name string) weather.LocationQuery {
+	return weather.LocationQuery{Kind: weather.LocationByName, Name: name}
+}
+
 func createSampleDomainWeather() *weather.Weather {
 	return &weather.Weather{
 		Location: weather.Location{
@@ -73,16 +79,10 @@ func TestGetWeatherHandler_InvalidLocation(t *testing.T) {
 	// Arrange
 	useCase := new(MockGetWeatherUseCase)
 	handler := NewWeatherHandler(useCase)
-	ctx := context.Background()
 
 	req := httptest.NewRequest(http.MethodGet, "/weather?city=%20%20", nil)
 	rec := httptest.NewRecorder()
 
-	useCase.
-		On("GetWeather", ctx, "  ").
-		Return(nil, weather.ErrInvalidLocation).
-		Once()
-
 	// Act
 	handler.GetWeatherHandler(rec, req)
 
@@ -90,7 +90,105 @@ func TestGetWeatherHandler_InvalidLocation(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 	assert.Contains(t, rec.Body.String(), "invalid location")
 
-	useCase.AssertExpectations(t)
+	// The location is rejected before reaching the use case
+	useCase.AssertNotCalled(t, "GetWeather", mock.Anything, mock.Anything)
+}
+
+func TestGetWeatherHandler_LocationQueries(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		remoteAddr    string
+		expectedQuery weather.LocationQuery
+	}{
+		{
+			name:          "Coordinates",
+			url:           "/weather?lat=51.5072&lon=-0.1276",
+			expectedQuery: weather.LocationQuery{Kind: weather.LocationByCoordinates, Latitude: 51.5072, Longitude: -0.1276},
+		},
+		{
+			name:          "Postcode",
+			url:           "/weather?postcode=sw1a%201aa",
+			expectedQuery: weather.LocationQuery{Kind: weather.LocationByPostcode, Name: "SW1A 1AA"},
+		},
+		{
+			name:          "IATA code",
+			url:           "/weather?iata=lhr",
+			expectedQuery: weather.LocationQuery{Kind: weather.LocationByIATA, Name: "LHR"},
+		},
+		{
+			name:          "Caller IP",
+			url:           "/weather?ip=auto",
+			remoteAddr:    "203.0.113.7:52814",
+			expectedQuery: weather.LocationQuery{Kind: weather.LocationByIP, IP: "203.0.113.7"},
+		},
+		{
+			name:          "Explicit IP",
+			url:           "/weather?ip=2001:db8::1",
+			expectedQuery: weather.LocationQuery{Kind: weather.LocationByIP, IP: "2001:db8::1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := new(MockGetWeatherUseCase)
+			handler := NewWeatherHandler(useCase)
+			ctx := context.Background()
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.remoteAddr != "" {
+				req.RemoteAddr = tt.remoteAddr
+			}
+			rec := httptest.NewRecorder()
+
+			useCase.
+				On("GetWeather", ctx, tt.expectedQuery).
+				Return(createSampleDomainWeather(), nil).
+				Once()
+
+			handler.GetWeatherHandler(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			useCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetWeatherHandler_InvalidLocationQueries(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		expectMessage string
+	}{
+		{name: "Latitude out of range", url: "/weather?lat=91&lon=0", expectMessage: "invalid coordinates"},
+		{name: "Longitude out of range", url: "/weather?lat=0&lon=-180.5", expectMessage: "invalid coordinates"},
+		{name: "Malformed coordinates", url: "/weather?lat=north&lon=0", expectMessage: "invalid coordinates"},
+		{name: "NaN latitude", url: "/weather?lat=NaN&lon=0", expectMessage: "invalid coordinates"},
+		{name: "NaN coordinates", url: "/weather?lat=nan&lon=nan", expectMessage: "invalid coordinates"},
+		{name: "Infinite longitude", url: "/weather?lat=0&lon=Inf", expectMessage: "invalid coordinates"},
+		{name: "Latitude without longitude", url: "/weather?lat=51.5", expectMessage: "lat and lon query parameters must be given together"},
+		{name: "Several locations", url: "/weather?city=London&iata=LHR", expectMessage: "only one of city"},
+		{name: "Malformed postcode", url: "/weather?postcode=SW1A_1AA", expectMessage: "invalid postcode"},
+		{name: "Malformed IATA code", url: "/weather?iata=LHR1", expectMessage: "invalid IATA code"},
+		{name: "Malformed IP", url: "/weather?ip=300.1.1.1", expectMessage: "invalid IP address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := new(MockGetWeatherUseCase)
+			handler := NewWeatherHandler(useCase)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+
+			handler.GetWeatherHandler(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectMessage)
+
+			useCase.AssertNotCalled(t, "GetWeather", mock.Anything, mock.Anything)
+		})
+	}
 }
 
 func TestGetWeatherHandler_WeatherNotFound(t *testing.T) {
@@ -103,7 +201,7 @@ func TestGetWeatherHandler_WeatherNotFound(t *testing.T) {
 	rec := httptest.NewRecorder()
 
 	useCase.
-		On("GetWeather", ctx, "NonExistent").
+		On("GetWeather", ctx, nameQuery("NonExistent")).
 		Return(nil, weather.ErrWeatherNotFound).
 		Once()
 
@@ -127,7 +225,7 @@ func TestGetWeatherHandler_WeatherUnavailable(t *testing.T) {
 	rec := httptest.NewRecorder()
 
 	useCase.
-		On("GetWeather", ctx, "Athens").
+		On("GetWeather", ctx, nameQuery("Athens")).
 		Return(nil, weather.ErrWeatherUnavailable).
 		Once()
 
@@ -141,6 +239,49 @@ func TestGetWeatherHandler_WeatherUnavailable(t *testing.T) {
 	useCase.AssertExpectations(t)
 }
 
+func TestGetWeatherHandler_ProviderQuotaAndConfiguration(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name:            "Quota exceeded",
+			err:             fmt.Errorf("%w: %w", weather.ErrWeatherUnavailable, weather.ErrQuotaExceeded),
+			expectedStatus:  http.StatusServiceUnavailable,
+			expectedMessage: "weather service quota exceeded",
+		},
+		{
+			name:            "Misconfigured",
+			err:             fmt.Errorf("%w: %w", weather.ErrWeatherUnavailable, weather.ErrProviderMisconfigured),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "weather service is misconfigured",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useCase := new(MockGetWeatherUseCase)
+			handler := NewWeatherHandler(useCase)
+
+			req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens", nil)
+			rec := httptest.NewRecorder()
+
+			useCase.On("GetWeather", mock.Anything, nameQuery("Athens")).Return(nil, tt.err)
+
+			// Act
+			handler.GetWeatherHandler(rec, req)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedMessage)
+			assert.NotContains(t, rec.Body.String(), "currently unavailable")
+		})
+	}
+}
+
 func TestGetWeatherHandler_UnknownError(t *testing.T) {
 	// Arrange
 	useCase := new(MockGetWeatherUseCase)
@@ -151,7 +292,7 @@ func TestGetWeatherHandler_UnknownError(t *testing.T) {
 	rec := httptest.NewRecorder()
 
 	useCase.
-		On("GetWeather", ctx, "Athens").
+		On("GetWeather", ctx, nameQuery("Athens")).
 		Return(nil, errors.New("unknown error")).
 		Once()
 
@@ -177,7 +318,7 @@ func TestGetWeatherHandler_Success(t *testing.T) {
 	weatherData := createSampleDomainWeather()
 
 	useCase.
-		On("GetWeather", ctx, "Athens").
+		On("GetWeather", ctx, nameQuery("Athens")).
 		Return(weatherData, nil).
 		Once()
 
@@ -226,7 +367,7 @@ func TestGetWeatherHandler_SpecialCharactersInCity(t *testing.T) {
 	}
 
 	useCase.
-		On("GetWeather", ctx, "São Paulo").
+		On("GetWeather", ctx, nameQuery("São Paulo")).
 		Return(weatherData, nil).
 		Once()
 
@@ -244,3 +385,385 @@ func TestGetWeatherHandler_SpecialCharactersInCity(t *testing.T) {
 
 	useCase.AssertExpectations(t)
 }
+
+func TestGetWeatherHandler_AirQuality(t *testing.T) {
+	weatherData := createSampleDomainWeather()
+	weatherData.Current.AirQuality = &weather.AirQuality{
+		NitrogenDioxide: 29.4,
+		PM2_5:           38.1,
+		USEPAIndex:      3,
+		UKDEFRAIndex:    5,
+	}
+
+	tests := []struct {
+		name             string
+		url              string
+		expectAirQuality bool
+	}{
+		{name: "Not requested", url: "/weather?city=Athens", expectAirQuality: false},
+		{name: "Explicitly disabled", url: "/weather?city=Athens&aqi=no", expectAirQuality: false},
+		{name: "Requested", url: "/weather?city=Athens&aqi=yes", expectAirQuality: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := new(MockGetWeatherUseCase)
+			handler := NewWeatherHandler(useCase)
+			ctx := context.Background()
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+
+			useCase.
+				On("GetWeather", ctx, nameQuery("Athens")).
+				Return(weatherData, nil).
+				Once()
+
+			handler.GetWeatherHandler(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response dto.WeatherResponse
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			if !tt.expectAirQuality {
+				assert.Nil(t, response.AirQuality)
+				assert.NotContains(t, rec.Body.String(), "air_quality")
+				return
+			}
+
+			require.NotNil(t, response.AirQuality)
+			assert.Equal(t, 29.4, response.AirQuality.NO2)
+			assert.Equal(t, 38.1, response.AirQuality.PM2_5)
+			assert.Equal(t, 3, response.AirQuality.USEPAIndex)
+			assert.Equal(t, "Unhealthy for Sensitive Groups", response.AirQuality.USEPACategory)
+			assert.Equal(t, "Moderate", response.AirQuality.UKDEFRABand)
+			assert.NotEmpty(t, response.AirQuality.HealthAdvice)
+
+			useCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetWeatherHandler_InvalidAirQualityParameter(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens&aqi=maybe", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "aqi query parameter must be yes or no")
+
+	useCase.AssertNotCalled(t, "GetWeather", mock.Anything, mock.Anything)
+}
+
+func TestGetWeatherHandler_DetailedResponse(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens&version=2", nil)
+	rec := httptest.NewRecorder()
+
+	weatherData := createSampleDomainWeather()
+	weatherData.Current.Wind = weather.Wind{SpeedKph: 18.4, Degree: 320, Direction: "NW"}
+	weatherData.Current.Pressure = weather.Pressure{Millibars: 1015, Inches: 29.97}
+	weatherData.Current.Humidity = 0
+	weatherData.Current.UVIndex = 7
+
+	useCase.
+		On("GetWeather", ctx, nameQuery("Athens")).
+		Return(weatherData, nil).
+		Once()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.WeatherDetailResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, dto.WeatherDetailSchemaVersion, response.SchemaVersion)
+	require.NotNil(t, response.Location)
+	assert.Equal(t, "Athens", response.Location.Name)
+	require.NotNil(t, response.Temperature)
+	assert.Equal(t, 24.5, response.Temperature.Value)
+	require.NotNil(t, response.Wind)
+	assert.Equal(t, "NW", response.Wind.Direction)
+	require.NotNil(t, response.Pressure)
+	assert.Equal(t, 1015.0, *response.Pressure)
+	require.NotNil(t, response.Humidity, "zero values must still be present")
+	assert.Equal(t, 0, *response.Humidity)
+	require.NotNil(t, response.UV)
+	assert.Equal(t, 7.0, response.UV.Index)
+	require.NotNil(t, response.Alerts)
+	assert.Empty(t, *response.Alerts)
+
+	// No air quality data was reported
+	assert.Nil(t, response.AirQuality)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetWeatherHandler_FieldSelection(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens&fields=wind,%20Pressure,uv", nil)
+	rec := httptest.NewRecorder()
+
+	useCase.
+		On("GetWeather", ctx, nameQuery("Athens")).
+		Return(createSampleDomainWeather(), nil).
+		Once()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body map[string]json.RawMessage
+	err := json.Unmarshal(rec.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	keys := make([]string, 0, len(body))
+	for key := range body {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"schema_version", "stale", "units", "wind", "pressure", "uv"}, keys)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetWeatherHandler_InvalidDetailParameters(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		expectMessage string
+	}{
+		{name: "Unknown field", url: "/weather?city=Athens&fields=wind,mood", expectMessage: `unknown field "mood"`},
+		{name: "Unknown version", url: "/weather?city=Athens&version=3", expectMessage: "version query parameter must be 1 or 2"},
+		{name: "Fields on version 1", url: "/weather?city=Athens&version=1&fields=wind", expectMessage: "fields query parameter requires version 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := new(MockGetWeatherUseCase)
+			handler := NewWeatherHandler(useCase)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+
+			handler.GetWeatherHandler(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectMessage)
+
+			useCase.AssertNotCalled(t, "GetWeather", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetWeatherHandler_Units(t *testing.T) {
+	tests := []struct {
+		name              string
+		url               string
+		header            string
+		opts              []Option
+		expectTemperature float64
+		expectSystem      string
+		expectLabel       string
+	}{
+		{name: "Metric by default", url: "/weather?city=Athens", expectTemperature: 24.5, expectSystem: "metric", expectLabel: "°C"},
+		{name: "Imperial from query", url: "/weather?city=Athens&units=imperial", expectTemperature: 76.1, expectSystem: "imperial", expectLabel: "°F"},
+		{name: "SI from header", url: "/weather?city=Athens", header: "SI", expectTemperature: 297.65, expectSystem: "si", expectLabel: "K"},
+		{name: "Query overrides header", url: "/weather?city=Athens&units=metric", header: "imperial", expectTemperature: 24.5, expectSystem: "metric", expectLabel: "°C"},
+		{name: "Server default", url: "/weather?city=Athens", opts: []Option{WithDefaultUnits(weather.UnitsImperial)}, expectTemperature: 76.1, expectSystem: "imperial", expectLabel: "°F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := new(MockGetWeatherUseCase)
+			handler := NewWeatherHandler(useCase, tt.opts...)
+			ctx := context.Background()
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.header != "" {
+				req.Header.Set("Accept-Units", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			useCase.
+				On("GetWeather", ctx, nameQuery("Athens")).
+				Return(createSampleDomainWeather(), nil).
+				Once()
+
+			handler.GetWeatherHandler(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response dto.WeatherResponse
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			// temperature_c stays in Celsius for existing clients
+			assert.Equal(t, 24.5, response.Temperature)
+			assert.Equal(t, tt.expectTemperature, response.ConvertedTemperature)
+			assert.Equal(t, tt.expectSystem, response.Units.System)
+			assert.Equal(t, tt.expectLabel, response.Units.Temperature)
+
+			useCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetWeatherHandler_DetailedResponseInSIUnits(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens&fields=wind,pressure,visibility&units=si", nil)
+	rec := httptest.NewRecorder()
+
+	weatherData := createSampleDomainWeather()
+	weatherData.Current.Wind = weather.Wind{SpeedKph: 36, GustKph: 54}
+	weatherData.Current.Pressure = weather.Pressure{Millibars: 1015}
+	weatherData.Current.Visibility = weather.Distance{Kilometers: 10}
+
+	useCase.
+		On("GetWeather", ctx, nameQuery("Athens")).
+		Return(weatherData, nil).
+		Once()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.WeatherDetailResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "m/s", response.Units.Speed)
+	require.NotNil(t, response.Wind)
+	assert.Equal(t, 10.0, response.Wind.Speed)
+	assert.Equal(t, 15.0, response.Wind.Gust)
+	require.NotNil(t, response.Pressure)
+	assert.Equal(t, 101500.0, *response.Pressure)
+	require.NotNil(t, response.Visibility)
+	assert.Equal(t, 10000.0, *response.Visibility)
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetWeatherHandler_InvalidUnits(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens", nil)
+	req.Header.Set("Accept-Units", "kelvin")
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), weather.ErrInvalidUnits.Error())
+
+	useCase.AssertNotCalled(t, "GetWeather", mock.Anything, mock.Anything)
+}
+
+func TestGetWeatherHandler_Insights(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens&fields=insights", nil)
+	rec := httptest.NewRecorder()
+
+	weatherData := createSampleDomainWeather()
+	weatherData.Current.Wind = weather.Wind{SpeedKph: 65}
+	weatherData.Current.Precipitation = weather.Precipitation{Millimeters: 4.2}
+	weatherData.Current.Visibility = weather.Distance{Kilometers: 8}
+	weatherData.Current.UVIndex = 6.5
+
+	useCase.
+		On("GetWeather", ctx, nameQuery("Athens")).
+		Return(weatherData, nil).
+		Once()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.WeatherDetailResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Nil(t, response.Temperature)
+	require.NotNil(t, response.Insights)
+	assert.Equal(t, "Sunny, Comfortable, Moderate Rain, Strong Winds", response.Insights.Description)
+	assert.Equal(t, "Comfortable", response.Insights.ComfortLevel)
+	assert.Equal(t, 8, response.Insights.BeaufortScale)
+	assert.Equal(t, "Gale", response.Insights.BeaufortDescription)
+	assert.Equal(t, "Moderate Rain", response.Insights.RainfallIntensity)
+	assert.Equal(t, "High", response.Insights.UVRisk)
+	assert.False(t, response.Insights.IsPoorVisibility)
+	assert.True(t, response.Insights.IsExtreme, "gale-force wind is extreme")
+
+	useCase.AssertExpectations(t)
+}
+
+func TestGetWeatherHandler_StaleData(t *testing.T) {
+	// Arrange
+	useCase := new(MockGetWeatherUseCase)
+	handler := NewWeatherHandler(useCase)
+	ctx := context.Background()
+
+	req := httptest.NewRequest(http.MethodGet, "/weather?city=Athens", nil)
+	rec := httptest.NewRecorder()
+
+	weatherData := createSampleDomainWeather()
+	weatherData.UpdatedAt = time.Now().Add(-90 * time.Minute)
+	weatherData.Stale = true
+
+	useCase.
+		On("GetWeather", ctx, nameQuery("Athens")).
+		Return(weatherData, nil).
+		Once()
+
+	// Act
+	handler.GetWeatherHandler(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "5400", rec.Header().Get("Age"))
+
+	var response dto.WeatherResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.True(t, response.Stale)
+
+	useCase.AssertExpectations(t)
+}
@@ -1,18 +1,43 @@
 package routes
 
 import (
+	"expvar"
 	"net/http"
 
 	"weather-api-wrapper/internal/adapters/input/http/handlers"
+	"weather-api-wrapper/internal/adapters/input/http/middleware/auth"
 	"weather-api-wrapper/internal/adapters/input/http/middleware/logging"
 	"weather-api-wrapper/internal/adapters/input/http/middleware/rate_limiter"
 )
 
+// Handlers groups the HTTP handlers exposed by the API
+type Handlers struct {
+	Weather   *handlers.WeatherHandler
+	Forecast  *handlers.ForecastHandler
+	History   *handlers.HistoryHandler
+	Alerts    *handlers.AlertHandler
+	Astronomy *handlers.AstronomyHandler
+	Search    *handlers.SearchHandler
+	Admin     *handlers.AdminHandler
+	Stats     *handlers.StatsHandler
+	Health    *handlers.HealthHandler
+}
+
 // SetupRoutes configures the HTTP routes with middleware chain
 // Middleware order: Logging (outer) -> Rate Limiter -> Handler (inner)
-func SetupRoutes(handler *handlers.WeatherHandler) http.Handler {
+// Admin routes additionally require adminToken; they are not served when it is empty.
+func SetupRoutes(h Handlers, adminToken string) http.Handler {
 	mux := http.NewServeMux()
-	mux.HandleFunc("/weather", handler.GetWeatherHandler)
+	mux.HandleFunc("/weather", h.Weather.GetWeatherHandler)
+	mux.HandleFunc("/forecast", h.Forecast.GetForecastHandler)
+	mux.HandleFunc("/history", h.History.GetHistoryHandler)
+	mux.HandleFunc("/alerts", h.Alerts.GetAlertsHandler)
+	mux.HandleFunc("/astronomy", h.Astronomy.GetAstronomyHandler)
+	mux.HandleFunc("/locations/search", h.Search.SearchLocationsHandler)
+	mux.HandleFunc("/health", h.Health.GetHealthHandler)
+	if adminToken != "" {
+		mux.Handle("/admin/", auth.BearerToken(adminToken, adminRoutes(h)))
+	}
 
 	// Apply rate limiting (30 requests per minute)
 	rateLimiter := rate_limiter.NewRateLimiter(30)
@@ -21,3 +46,21 @@ func SetupRoutes(handler *handlers.WeatherHandler) http.Handler {
 	// Apply logging (outermost middleware for visibility)
 	return logging.LoggingMiddleware(withRateLimit)
 }
+
+// adminRoutes configures the cache administration and statistics routes
+// The process metrics published with expvar are served alongside them.
+func adminRoutes(h Handlers) http.Handler {
+	mux := http.NewServeMux()
+	if h.Admin != nil {
+		mux.HandleFunc("GET /admin/cache", h.Admin.ListCacheHandler)
+		mux.HandleFunc("DELETE /admin/cache", h.Admin.InvalidatePrefixHandler)
+		mux.HandleFunc("GET /admin/cache/entry", h.Admin.GetCacheEntryHandler)
+		mux.HandleFunc("DELETE /admin/cache/location", h.Admin.InvalidateLocationHandler)
+		mux.HandleFunc("POST /admin/cache/flush", h.Admin.FlushCacheHandler)
+	}
+	if h.Stats != nil {
+		mux.HandleFunc("GET /admin/stats", h.Stats.GetStatsHandler)
+	}
+	mux.Handle("GET /admin/metrics", expvar.Handler())
+	return mux
+}
@@ -0,0 +1,214 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// ErrOpen is returned without calling the upstream while the circuit is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// Settings controls when a circuit breaker trips and recovers
+type Settings struct {
+	// Window is how long calls are counted before the counts start over
+	Window time.Duration
+	// MinRequests is the number of calls in a window before the failure rate is considered
+	MinRequests int
+	// FailureRate is the share of failed calls in a window that opens the circuit
+	FailureRate float64
+	// CoolDown is how long the circuit stays open before trial calls are let through
+	CoolDown time.Duration
+	// HalfOpenProbes is the number of trial calls that must succeed to close the circuit
+	HalfOpenProbes int
+}
+
+// DefaultSettings returns the settings used unless others are configured
+func DefaultSettings() Settings {
+	return Settings{
+		Window:         30 * time.Second,
+		MinRequests:    10,
+		FailureRate:    0.5,
+		CoolDown:       30 * time.Second,
+		HalfOpenProbes: 1,
+	}
+}
+
+// Breaker is a circuit breaker
+// While closed, calls go through and their outcomes are counted per window;
+// when enough of them fail, the circuit opens and calls fail fast with ErrOpen.
+// After the cool-down it is half-open: up to HalfOpenProbes trial calls go
+// through at a time, and the circuit closes once that many succeed, or opens
+// again on the first failure.
+type Breaker struct {
+	name     string
+	settings Settings
+	now      func() time.Time
+
+	mu    sync.Mutex
+	state weather.CircuitState
+	since time.Time
+	// generation changes with every state change and window, so outcomes of
+	// calls allowed in an earlier one are not counted in the current one
+	generation  uint64
+	windowStart time.Time
+	requests    int
+	failures    int
+	inFlight    int // trial calls while half-open
+	successes   int // successful trial calls while half-open
+}
+
+// New creates a closed circuit breaker
+func New(name string, settings Settings) *Breaker {
+	return newBreaker(name, settings, time.Now)
+}
+
+// newBreaker creates a closed circuit breaker reading time from now
+func newBreaker(name string, settings Settings, now func() time.Time) *Breaker {
+	settings.MinRequests = max(settings.MinRequests, 1)
+	settings.HalfOpenProbes = max(settings.HalfOpenProbes, 1)
+	start := now()
+	return &Breaker{
+		name:        name,
+		settings:    settings,
+		now:         now,
+		state:       weather.CircuitClosed,
+		since:       start,
+		windowStart: start,
+	}
+}
+
+// Health describes the state of the breaker
+func (b *Breaker) Health() weather.UpstreamHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(b.now())
+	return weather.UpstreamHealth{
+		Name:     b.name,
+		State:    b.state,
+		Since:    b.since,
+		Requests: b.requests,
+		Failures: b.failures,
+	}
+}
+
+// call runs fn unless the circuit is open, and counts its outcome
+func call[T any](b *Breaker, fn func() (*T, error)) (*T, error) {
+	generation, err := b.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := fn()
+	b.record(generation, err)
+	return value, err
+}
+
+// allow decides whether a call may go through and returns the generation it belongs to
+func (b *Breaker) allow() (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(b.now())
+	switch b.state {
+	case weather.CircuitOpen:
+		return 0, ErrOpen
+	case weather.CircuitHalfOpen:
+		if b.inFlight >= b.settings.HalfOpenProbes {
+			return 0, ErrOpen
+		}
+		b.inFlight++
+	}
+	return b.generation, nil
+}
+
+// record counts the outcome of a call allowed in generation
+func (b *Breaker) record(generation uint64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.advance(now)
+	if generation != b.generation {
+		return
+	}
+
+	counted, failed := classify(err)
+	switch b.state {
+	case weather.CircuitClosed:
+		if !counted {
+			return
+		}
+		b.requests++
+		if failed {
+			b.failures++
+		}
+		if b.requests >= b.settings.MinRequests && float64(b.failures) >= b.settings.FailureRate*float64(b.requests) {
+			b.transition(weather.CircuitOpen, now)
+		}
+	case weather.CircuitHalfOpen:
+		b.inFlight--
+		if !counted {
+			return
+		}
+		if failed {
+			b.transition(weather.CircuitOpen, now)
+			return
+		}
+		b.successes++
+		if b.successes >= b.settings.HalfOpenProbes {
+			b.transition(weather.CircuitClosed, now)
+		}
+	}
+}
+
+// advance applies the changes due by now: a new window while closed, or the end of the cool-down
+func (b *Breaker) advance(now time.Time) {
+	switch b.state {
+	case weather.CircuitClosed:
+		if b.settings.Window > 0 && now.Sub(b.windowStart) >= b.settings.Window {
+			b.reset(now)
+		}
+	case weather.CircuitOpen:
+		if now.Sub(b.since) >= b.settings.CoolDown {
+			b.transition(weather.CircuitHalfOpen, now)
+		}
+	}
+}
+
+// transition moves the breaker to a new state and starts counting afresh
+func (b *Breaker) transition(state weather.CircuitState, now time.Time) {
+	log.Printf("Circuit breaker %s: %s -> %s", b.name, b.state, state)
+	b.state = state
+	b.since = now
+	b.reset(now)
+}
+
+// reset clears the counts and starts a new generation
+func (b *Breaker) reset(now time.Time) {
+	b.generation++
+	b.windowStart = now
+	b.requests = 0
+	b.failures = 0
+	b.inFlight = 0
+	b.successes = 0
+}
+
+// classify tells whether the outcome of a call says anything about the
+// upstream's health, and if so whether it failed
+// A caller giving up says nothing about the upstream's health, and an upstream
+// rejecting an unknown or invalid location is working as intended.
+func classify(err error) (counted, failed bool) {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return false, false
+	case errors.Is(err, weather.ErrWeatherNotFound), errors.Is(err, weather.ErrInvalidLocation):
+		return true, false
+	}
+	return true, err != nil
+}
@@ -0,0 +1,250 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+var errUpstream = errors.New("upstream error")
+
+// fakeClock is a manually advanced clock
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 2, 10, 15, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func testSettings() Settings {
+	return Settings{
+		Window:         time.Minute,
+		MinRequests:    4,
+		FailureRate:    0.5,
+		CoolDown:       30 * time.Second,
+		HalfOpenProbes: 2,
+	}
+}
+
+// run makes a call through the breaker that fails with err
+func run(b *Breaker, err error) error {
+	_, callErr := call(b, func() (*struct{}, error) {
+		return &struct{}{}, err
+	})
+	return callErr
+}
+
+// trip opens the circuit
+func trip(t *testing.T, b *Breaker) {
+	t.Helper()
+	for range 4 {
+		_ = run(b, errUpstream)
+	}
+	require.Equal(t, weather.CircuitOpen, b.Health().State)
+}
+
+func TestBreaker_StaysClosedBelowFailureRate(t *testing.T) {
+	// Arrange
+	b := newBreaker("weatherapi.com", testSettings(), newFakeClock().Now)
+
+	// Act
+	for _, err := range []error{errUpstream, nil, nil, nil, errUpstream} {
+		_ = run(b, err)
+	}
+
+	// Assert
+	health := b.Health()
+	assert.Equal(t, weather.CircuitClosed, health.State)
+	assert.Equal(t, 5, health.Requests)
+	assert.Equal(t, 2, health.Failures)
+}
+
+func TestBreaker_WaitsForMinRequests(t *testing.T) {
+	// Arrange
+	b := newBreaker("weatherapi.com", testSettings(), newFakeClock().Now)
+
+	// Act
+	for range 3 {
+		_ = run(b, errUpstream)
+	}
+
+	// Assert
+	assert.Equal(t, weather.CircuitClosed, b.Health().State)
+}
+
+func TestBreaker_OpensAndFailsFast(t *testing.T) {
+	// Arrange
+	b := newBreaker("weatherapi.com", testSettings(), newFakeClock().Now)
+	trip(t, b)
+	called := false
+
+	// Act
+	_, err := call(b, func() (*struct{}, error) {
+		called = true
+		return nil, nil
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.False(t, called, "the upstream is not called while the circuit is open")
+}
+
+func TestBreaker_CountsStartOverEachWindow(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	b := newBreaker("weatherapi.com", testSettings(), clock.Now)
+	for range 3 {
+		_ = run(b, errUpstream)
+	}
+
+	// Act
+	clock.Advance(time.Minute)
+	_ = run(b, errUpstream)
+
+	// Assert
+	health := b.Health()
+	assert.Equal(t, weather.CircuitClosed, health.State)
+	assert.Equal(t, 1, health.Requests)
+}
+
+func TestBreaker_HalfOpenAfterCoolDown_ClosesOnSuccesses(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	b := newBreaker("weatherapi.com", testSettings(), clock.Now)
+	trip(t, b)
+
+	// Act
+	clock.Advance(30 * time.Second)
+	require.Equal(t, weather.CircuitHalfOpen, b.Health().State)
+	require.NoError(t, run(b, nil))
+	assert.Equal(t, weather.CircuitHalfOpen, b.Health().State, "one probe is not enough")
+	require.NoError(t, run(b, nil))
+
+	// Assert
+	health := b.Health()
+	assert.Equal(t, weather.CircuitClosed, health.State)
+	assert.Equal(t, clock.Now(), health.Since)
+	assert.Zero(t, health.Requests)
+}
+
+func TestBreaker_HalfOpen_ReopensOnFailure(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	b := newBreaker("weatherapi.com", testSettings(), clock.Now)
+	trip(t, b)
+	clock.Advance(30 * time.Second)
+
+	// Act
+	err := run(b, errUpstream)
+
+	// Assert
+	assert.ErrorIs(t, err, errUpstream)
+	assert.Equal(t, weather.CircuitOpen, b.Health().State)
+	assert.ErrorIs(t, run(b, nil), ErrOpen, "a new cool-down starts")
+}
+
+func TestBreaker_HalfOpen_LimitsConcurrentProbes(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	b := newBreaker("weatherapi.com", testSettings(), clock.Now)
+	trip(t, b)
+	clock.Advance(30 * time.Second)
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = call(b, func() (*struct{}, error) {
+				started <- struct{}{}
+				<-release
+				return &struct{}{}, nil
+			})
+		}()
+	}
+	<-started
+	<-started
+
+	// Act
+	err := run(b, nil)
+	close(release)
+	wg.Wait()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.Equal(t, weather.CircuitClosed, b.Health().State)
+}
+
+func TestBreaker_IgnoresCallerCancellation(t *testing.T) {
+	// Arrange
+	b := newBreaker("weatherapi.com", testSettings(), newFakeClock().Now)
+
+	// Act
+	for range 4 {
+		_ = run(b, context.Canceled)
+	}
+
+	// Assert
+	health := b.Health()
+	assert.Equal(t, weather.CircuitClosed, health.State)
+	assert.Zero(t, health.Requests)
+}
+
+func TestBreaker_RejectedLocationsAreNotFailures(t *testing.T) {
+	// Arrange
+	b := newBreaker("weatherapi.com", testSettings(), newFakeClock().Now)
+	notFound := fmt.Errorf("API returned non-OK status: %w", weather.ErrWeatherNotFound)
+
+	// Act
+	for _, err := range []error{notFound, notFound, weather.ErrInvalidLocation, errUpstream} {
+		_ = run(b, err)
+	}
+
+	// Assert
+	health := b.Health()
+	assert.Equal(t, weather.CircuitClosed, health.State)
+	assert.Equal(t, 4, health.Requests)
+	assert.Equal(t, 1, health.Failures)
+}
+
+func TestBreaker_IgnoresOutcomesFromEarlierState(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	b := newBreaker("weatherapi.com", testSettings(), clock.Now)
+	generation, err := b.allow()
+	require.NoError(t, err)
+	trip(t, b)
+	clock.Advance(30 * time.Second)
+
+	// Act: a slow call allowed while closed completes after the cool-down
+	b.record(generation, nil)
+
+	// Assert
+	health := b.Health()
+	assert.Equal(t, weather.CircuitHalfOpen, health.State)
+	assert.Zero(t, health.Requests)
+}
@@ -0,0 +1,83 @@
+package breaker
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// Upstream is a provider of every kind of weather data, such as the WeatherAPI.com client
+type Upstream interface {
+	output.WeatherProvider
+	output.ForecastProvider
+	output.HistoryProvider
+	output.AlertProvider
+	output.AstronomyProvider
+	output.LocationSearchProvider
+}
+
+// Provider guards an upstream provider with a circuit breaker
+// It implements every provider port; all of them share the breaker, since
+// they fail together when the upstream is unhealthy. While the circuit is
+// open, calls fail fast with ErrOpen instead of waiting on the upstream.
+type Provider struct {
+	upstream Upstream
+	breaker  *Breaker
+}
+
+// NewProvider wraps an upstream provider in a circuit breaker named after it
+func NewProvider(name string, upstream Upstream, settings Settings) *Provider {
+	return &Provider{
+		upstream: upstream,
+		breaker:  New(name, settings),
+	}
+}
+
+// UpstreamHealth implements the UpstreamHealthReporter port
+func (p *Provider) UpstreamHealth() weather.UpstreamHealth {
+	return p.breaker.Health()
+}
+
+// FetchWeather implements the WeatherProvider port
+func (p *Provider) FetchWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error) {
+	return call(p.breaker, func() (*weather.Weather, error) {
+		return p.upstream.FetchWeather(ctx, query)
+	})
+}
+
+// FetchForecast implements the ForecastProvider port
+func (p *Provider) FetchForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error) {
+	return call(p.breaker, func() (*weather.Forecast, error) {
+		return p.upstream.FetchForecast(ctx, query, days)
+	})
+}
+
+// FetchHistory implements the HistoryProvider port
+func (p *Provider) FetchHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error) {
+	return call(p.breaker, func() (*weather.History, error) {
+		return p.upstream.FetchHistory(ctx, query, from, to)
+	})
+}
+
+// FetchAlerts implements the AlertProvider port
+func (p *Provider) FetchAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error) {
+	return call(p.breaker, func() (*weather.WeatherAlerts, error) {
+		return p.upstream.FetchAlerts(ctx, query)
+	})
+}
+
+// FetchAstronomy implements the AstronomyProvider port
+func (p *Provider) FetchAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error) {
+	return call(p.breaker, func() (*weather.AstronomyReport, error) {
+		return p.upstream.FetchAstronomy(ctx, query, date)
+	})
+}
+
+// SearchLocations implements the LocationSearchProvider port
+func (p *Provider) SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error) {
+	return call(p.breaker, func() (*weather.LocationSearch, error) {
+		return p.upstream.SearchLocations(ctx, query)
+	})
+}
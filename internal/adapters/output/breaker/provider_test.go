@@ -0,0 +1,141 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/output/weatherapi"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockUpstream struct {
+	mock.Mock
+}
+
+func (m *MockUpstream) FetchWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Weather), args.Error(1)
+}
+
+func (m *MockUpstream) FetchForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error) {
+	args := m.Called(ctx, query, days)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Forecast), args.Error(1)
+}
+
+func (m *MockUpstream) FetchHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error) {
+	args := m.Called(ctx, query, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.History), args.Error(1)
+}
+
+func (m *MockUpstream) FetchAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.WeatherAlerts), args.Error(1)
+}
+
+func (m *MockUpstream) FetchAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error) {
+	args := m.Called(ctx, query, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.AstronomyReport), args.Error(1)
+}
+
+func (m *MockUpstream) SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.LocationSearch), args.Error(1)
+}
+
+func TestProvider_PassesThroughWhileClosed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByName, Name: "London"}
+	upstream := new(MockUpstream)
+	upstream.On("FetchWeather", ctx, query).Return(&weather.Weather{Location: weather.Location{Name: "London"}}, nil)
+
+	provider := NewProvider("weatherapi.com", upstream, DefaultSettings())
+
+	// Act
+	result, err := provider.FetchWeather(ctx, query)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "London", result.Location.Name)
+	assert.Equal(t, weather.UpstreamHealth{
+		Name:     "weatherapi.com",
+		State:    weather.CircuitClosed,
+		Since:    provider.UpstreamHealth().Since,
+		Requests: 1,
+	}, provider.UpstreamHealth())
+}
+
+func TestProvider_SharesBreakerAcrossPorts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByName, Name: "London"}
+	upstream := new(MockUpstream)
+	upstream.On("FetchForecast", ctx, query, 3).Return(nil, errors.New("connection refused"))
+
+	settings := DefaultSettings()
+	settings.MinRequests = 2
+	provider := NewProvider("weatherapi.com", upstream, settings)
+
+	// Act
+	for range 2 {
+		_, err := provider.FetchForecast(ctx, query, 3)
+		require.Error(t, err)
+	}
+	result, err := provider.SearchLocations(ctx, "Lon")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.False(t, provider.UpstreamHealth().Available())
+	upstream.AssertNotCalled(t, "SearchLocations", mock.Anything, mock.Anything)
+}
+
+func TestProvider_IgnoresCallerCancellationThroughClient(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	query := weather.LocationQuery{Kind: weather.LocationByName, Name: "London"}
+	provider := NewProvider("weatherapi.com", weatherapi.NewClient("test_api_key", server.URL), testSettings())
+
+	// Act
+	for range 4 {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		_, err := provider.FetchForecast(ctx, query, 3)
+		require.ErrorIs(t, err, context.Canceled)
+	}
+
+	// Assert
+	health := provider.UpstreamHealth()
+	assert.Equal(t, weather.CircuitClosed, health.State)
+	assert.Zero(t, health.Requests)
+}
@@ -1,17 +1,87 @@
 package config
 
 import (
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Cache backends selectable with CACHE_BACKEND
+const (
+	CacheBackendRedis  = "redis"  // Redis, with an in-process cache in front
+	CacheBackendMemory = "memory" // in-process cache only, not shared between instances
+	CacheBackendNone   = "none"   // no caching
+)
+
 // Config holds all configuration values for the application
 type Config struct {
 	WeatherAPIKey     string
 	WeatherAPIBaseURL string
 	RedisHost         string
 	RedisPort         string
+	DefaultUnits      string
+	CacheBackend      string
+	CacheCompression  bool
+
+	// HTTP client used to call the weather API
+	WeatherAPITimeout               time.Duration // per attempt
+	WeatherAPICallTimeout           time.Duration // per call, retries included
+	WeatherAPIDialTimeout           time.Duration
+	WeatherAPITLSHandshakeTimeout   time.Duration
+	WeatherAPIResponseHeaderTimeout time.Duration
+	WeatherAPIKeepAlive             time.Duration
+	WeatherAPIIdleConnTimeout       time.Duration
+	WeatherAPIMaxIdleConns          int
+	WeatherAPIMaxConnsPerHost       int
+	WeatherAPIProxy                 string // empty uses HTTP_PROXY/HTTPS_PROXY
+
+	// Retries of failed WeatherAPI requests; 1 attempt disables them
+	WeatherAPIMaxAttempts    int
+	WeatherAPIRetryBaseDelay time.Duration
+	WeatherAPIRetryMaxDelay  time.Duration
+
+	// Circuit breaker around the weather API
+	BreakerWindow         time.Duration
+	BreakerMinRequests    int
+	BreakerFailureRate    float64
+	BreakerCoolDown       time.Duration
+	BreakerHalfOpenProbes int
+
+	// Cache lifetimes per data type
+	CacheTTLCurrent       time.Duration
+	CacheTTLForecast      time.Duration
+	CacheTTLHistory       time.Duration
+	CacheTTLRecentHistory time.Duration
+	CacheTTLAlerts        time.Duration
+	CacheTTLAstronomy     time.Duration
+	CacheTTLSearch        time.Duration
+
+	// CacheObservationInterval aligns current weather expiry to the provider's observations; 0 disables it
+	CacheObservationInterval time.Duration
+	// CacheTTLJitter shortens TTLs by a random fraction of up to this value; 0 disables it
+	CacheTTLJitter float64
+
+	// Stale current weather lifetimes
+	CacheStaleWhileRevalidate time.Duration
+	CacheHardTTL              time.Duration
+
+	// In-process cache, per data type
+	MemoryCacheSize int
+	MemoryCacheTTL  time.Duration
+
+	// Background refresh of popular locations; a zero interval disables it
+	RefreshInterval      time.Duration
+	RefreshTopLocations  int
+	RefreshLead          time.Duration
+	RefreshBudget        int    // upstream calls per minute
+	RefreshLocationsFile string // locations always refreshed, see LoadLocations
+
+	// AdminToken is the bearer token required by the admin endpoints; empty disables them
+	AdminToken string
 }
 
 // Load loads configuration from environment variables with fallback defaults
@@ -22,9 +92,58 @@ func Load() *Config {
 
 	return &Config{
 		WeatherAPIKey:     getEnv("WEATHER_API_KEY", "test_api_key"),
-		WeatherAPIBaseURL: getEnv("WEATHER_API_BASE_URL", "https://base-url.com"),
+		WeatherAPIBaseURL: getEnvBaseURL("WEATHER_API_BASE_URL", "https://api.weatherapi.com/v1"),
 		RedisHost:         getEnv("REDIS_HOST", "localhost"),
 		RedisPort:         getEnv("REDIS_PORT", "6379"),
+		DefaultUnits:      getEnv("DEFAULT_UNITS", "metric"),
+		CacheBackend:      getEnv("CACHE_BACKEND", CacheBackendRedis),
+		CacheCompression:  getEnvBool("CACHE_COMPRESSION", true),
+
+		WeatherAPITimeout:               getEnvDuration("WEATHER_API_TIMEOUT", 10*time.Second),
+		WeatherAPICallTimeout:           getEnvDuration("WEATHER_API_CALL_TIMEOUT", 15*time.Second),
+		WeatherAPIDialTimeout:           getEnvDuration("WEATHER_API_DIAL_TIMEOUT", 5*time.Second),
+		WeatherAPITLSHandshakeTimeout:   getEnvDuration("WEATHER_API_TLS_HANDSHAKE_TIMEOUT", 5*time.Second),
+		WeatherAPIResponseHeaderTimeout: getEnvDuration("WEATHER_API_RESPONSE_HEADER_TIMEOUT", 8*time.Second),
+		WeatherAPIKeepAlive:             getEnvDuration("WEATHER_API_KEEP_ALIVE", 30*time.Second),
+		WeatherAPIIdleConnTimeout:       getEnvDuration("WEATHER_API_IDLE_CONN_TIMEOUT", 90*time.Second),
+		WeatherAPIMaxIdleConns:          getEnvLimit("WEATHER_API_MAX_IDLE_CONNS", 100),
+		WeatherAPIMaxConnsPerHost:       getEnvLimit("WEATHER_API_MAX_CONNS_PER_HOST", 100),
+		WeatherAPIProxy:                 getEnv("WEATHER_API_PROXY", ""),
+
+		WeatherAPIMaxAttempts:    getEnvInt("WEATHER_API_MAX_ATTEMPTS", 3),
+		WeatherAPIRetryBaseDelay: getEnvDuration("WEATHER_API_RETRY_BASE_DELAY", 200*time.Millisecond),
+		WeatherAPIRetryMaxDelay:  getEnvDuration("WEATHER_API_RETRY_MAX_DELAY", 2*time.Second),
+
+		BreakerWindow:         getEnvDuration("BREAKER_WINDOW", 30*time.Second),
+		BreakerMinRequests:    getEnvInt("BREAKER_MIN_REQUESTS", 10),
+		BreakerFailureRate:    getEnvFraction("BREAKER_FAILURE_RATE", 0.5, false),
+		BreakerCoolDown:       getEnvDuration("BREAKER_COOL_DOWN", 30*time.Second),
+		BreakerHalfOpenProbes: getEnvInt("BREAKER_HALF_OPEN_PROBES", 1),
+
+		CacheTTLCurrent:       getEnvDuration("CACHE_TTL_CURRENT", 15*time.Minute),
+		CacheTTLForecast:      getEnvDuration("CACHE_TTL_FORECAST", 3*time.Hour),
+		CacheTTLHistory:       getEnvDuration("CACHE_TTL_HISTORY", 30*24*time.Hour),
+		CacheTTLRecentHistory: getEnvDuration("CACHE_TTL_RECENT_HISTORY", time.Hour),
+		CacheTTLAlerts:        getEnvDuration("CACHE_TTL_ALERTS", 5*time.Minute),
+		CacheTTLAstronomy:     getEnvDuration("CACHE_TTL_ASTRONOMY", 7*24*time.Hour),
+		CacheTTLSearch:        getEnvDuration("CACHE_TTL_SEARCH", 24*time.Hour),
+
+		CacheObservationInterval: getEnvDuration("CACHE_OBSERVATION_INTERVAL", 15*time.Minute),
+		CacheTTLJitter:           getEnvFraction("CACHE_TTL_JITTER", 0.1, true),
+
+		CacheStaleWhileRevalidate: getEnvDuration("CACHE_STALE_WHILE_REVALIDATE", time.Hour),
+		CacheHardTTL:              getEnvDuration("CACHE_HARD_TTL", 24*time.Hour),
+
+		MemoryCacheSize: getEnvInt("MEMORY_CACHE_SIZE", 1000),
+		MemoryCacheTTL:  getEnvDuration("MEMORY_CACHE_TTL", 30*time.Second),
+
+		RefreshInterval:      getEnvDuration("REFRESH_INTERVAL", time.Minute),
+		RefreshTopLocations:  getEnvInt("REFRESH_TOP_LOCATIONS", 200),
+		RefreshLead:          getEnvDuration("REFRESH_LEAD", 2*time.Minute),
+		RefreshBudget:        getEnvInt("REFRESH_BUDGET", 60),
+		RefreshLocationsFile: getEnv("REFRESH_LOCATIONS_FILE", ""),
+
+		AdminToken: getEnv("ADMIN_TOKEN", ""),
 	}
 }
 
@@ -35,3 +154,96 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// getEnvBaseURL retrieves an environment variable as the root URL of an API
+// Earlier releases took the full URL of the current weather endpoint, e.g.
+// https://api.weatherapi.com/v1/current.json; a trailing "/<name>.json"
+// segment is dropped so such settings keep working.
+func getEnvBaseURL(key, fallback string) string {
+	value := getEnv(key, fallback)
+	trimmed := strings.TrimRight(value, "/")
+	i := strings.LastIndex(trimmed, "/")
+	if i < 0 || !strings.HasSuffix(trimmed, ".json") || strings.HasSuffix(trimmed[:i], "/") {
+		return value
+	}
+	root := trimmed[:i]
+	log.Printf("Warning: %s %q names an endpoint, using its root %q", key, value, root)
+	return root
+}
+
+// getEnvBool retrieves an environment variable as a boolean ("true", "false", "1", "0", ...)
+// It returns the fallback value if the variable is unset or invalid
+func getEnvBool(key string, fallback bool) bool {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %t", key, value, fallback)
+		return fallback
+	}
+	return b
+}
+
+// getEnvDuration retrieves an environment variable as a duration (e.g. "15m", "720h")
+// It returns the fallback value if the variable is unset or invalid
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, fallback)
+		return fallback
+	}
+	return d
+}
+
+// getEnvFraction retrieves an environment variable as a fraction up to 1, and above 0 unless allowZero
+// It returns the fallback value if the variable is unset or invalid
+func getEnvFraction(key string, fallback float64, allowZero bool) float64 {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	// Written as in-range checks so NaN is rejected
+	inRange := f > 0 || (allowZero && f == 0)
+	if err != nil || !inRange || !(f <= 1) {
+		log.Printf("Warning: invalid %s %q, using %g", key, value, fallback)
+		return fallback
+	}
+	return f
+}
+
+// getEnvInt retrieves an environment variable as a positive integer
+// It returns the fallback value if the variable is unset or invalid
+func getEnvInt(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Printf("Warning: invalid %s %q, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
+
+// getEnvLimit retrieves an environment variable as a non-negative limit, where 0 means no limit
+// It returns the fallback value if the variable is unset or invalid
+func getEnvLimit(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		log.Printf("Warning: invalid %s %q, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
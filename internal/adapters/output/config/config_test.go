@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnvBaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "API root", value: "https://api.weatherapi.com/v1", expected: "https://api.weatherapi.com/v1"},
+		{name: "API root with trailing slash", value: "https://api.weatherapi.com/v1/", expected: "https://api.weatherapi.com/v1/"},
+		{name: "Legacy endpoint URL", value: "https://api.weatherapi.com/v1/current.json", expected: "https://api.weatherapi.com/v1"},
+		{name: "Other endpoint URL", value: "http://localhost:8081/v1/forecast.json/", expected: "http://localhost:8081/v1"},
+		{name: "Host named like an endpoint", value: "http://api.json", expected: "http://api.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WEATHER_API_BASE_URL", tt.value)
+
+			assert.Equal(t, tt.expected, getEnvBaseURL("WEATHER_API_BASE_URL", "https://api.weatherapi.com/v1"))
+		})
+	}
+}
+
+func TestGetEnvFraction(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		allowZero bool
+		expected  float64
+	}{
+		{name: "Fraction", value: "0.25", expected: 0.25},
+		{name: "One", value: "1", expected: 1},
+		{name: "Zero", value: "0", expected: 0.5},
+		{name: "Zero allowed", value: "0", allowZero: true, expected: 0},
+		{name: "Negative", value: "-0.1", allowZero: true, expected: 0.5},
+		{name: "Above one", value: "1.5", expected: 0.5},
+		{name: "NaN", value: "NaN", expected: 0.5},
+		{name: "Malformed", value: "half", expected: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BREAKER_FAILURE_RATE", tt.value)
+
+			assert.Equal(t, tt.expected, getEnvFraction("BREAKER_FAILURE_RATE", 0.5, tt.allowZero))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// LoadLocations reads a locations file, as used for cache warm-up and pinned refreshes
+// See ParseLocations for the format.
+func LoadLocations(path string) ([]weather.LocationQuery, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open locations file: %w", err)
+	}
+	defer f.Close()
+
+	return ParseLocations(f)
+}
+
+// ParseLocations parses one location per line: a place name ("London"),
+// coordinates ("51.52,-0.11"), "postcode:SW1A 1AA" or "iata:LHR"
+// Blank lines and lines starting with # are ignored.
+func ParseLocations(r io.Reader) ([]weather.LocationQuery, error) {
+	var queries []weather.LocationQuery
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		query, err := parseLocation(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		queries = append(queries, query)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read locations: %w", err)
+	}
+
+	return queries, nil
+}
+
+// parseLocation parses a single location line
+func parseLocation(text string) (weather.LocationQuery, error) {
+	if postcode, ok := strings.CutPrefix(text, "postcode:"); ok {
+		return weather.NewPostcodeQuery(postcode)
+	}
+	if code, ok := strings.CutPrefix(text, "iata:"); ok {
+		return weather.NewIATAQuery(code)
+	}
+
+	// "Paris, France" is a name; only two numbers make coordinates
+	if lat, lon, ok := strings.Cut(text, ","); ok && isNumber(lat) && isNumber(lon) {
+		return weather.ParseCoordinatesQuery(lat, lon)
+	}
+	return weather.NewNameQuery(text)
+}
+
+// isNumber reports whether s, once trimmed, is a decimal number
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return err == nil
+}
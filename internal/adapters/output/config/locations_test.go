@@ -0,0 +1,50 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestParseLocations(t *testing.T) {
+	input := `# Hot cities
+London
+  Paris, France
+
+51.52,-0.11
+postcode:SW1A 1AA
+iata:lhr
+`
+
+	queries, err := ParseLocations(strings.NewReader(input))
+
+	require.NoError(t, err)
+	assert.Equal(t, []weather.LocationQuery{
+		{Kind: weather.LocationByName, Name: "London"},
+		{Kind: weather.LocationByName, Name: "Paris, France"},
+		{Kind: weather.LocationByCoordinates, Latitude: 51.52, Longitude: -0.11},
+		{Kind: weather.LocationByPostcode, Name: "SW1A 1AA"},
+		{Kind: weather.LocationByIATA, Name: "LHR"},
+	}, queries)
+}
+
+func TestParseLocations_NaNCoordinates(t *testing.T) {
+	queries, err := ParseLocations(strings.NewReader("NaN,0\n"))
+
+	assert.ErrorIs(t, err, weather.ErrInvalidCoordinates)
+	assert.Nil(t, queries)
+}
+
+func TestParseLocations_InvalidLine(t *testing.T) {
+	input := "London\n95,10\n"
+
+	queries, err := ParseLocations(strings.NewReader(input))
+
+	assert.ErrorIs(t, err, weather.ErrInvalidCoordinates)
+	assert.ErrorContains(t, err, "line 2")
+	assert.Nil(t, queries)
+}
@@ -0,0 +1,258 @@
+package memory
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// Cache is an in-process LRU cache with a per-entry TTL
+// It implements the cache ports for any value type. Values are stored by
+// reference, so they must not be modified after Set or Get.
+// Once it holds maxEntries entries, setting a new key evicts the least recently used one.
+type Cache[T any] struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // most recently used at the front
+	now        func() time.Time
+}
+
+// maxAliasHops bounds how many aliases are followed to reach a value
+// Aliases are set pointing at a value, but that value may later be replaced by
+// an alias itself; the bound turns an accidental cycle into a cache miss.
+const maxAliasHops = 4
+
+// entry is a cached value, or an alias pointing at another key
+type entry[T any] struct {
+	key       string
+	value     *T
+	target    string // key an alias points to; empty for values
+	expiresAt time.Time
+}
+
+// NewCache creates an in-memory cache holding at most maxEntries entries
+func NewCache[T any](maxEntries int) *Cache[T] {
+	return &Cache[T]{
+		maxEntries: max(maxEntries, 1),
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get retrieves a value from the cache, following an alias to the entry it points to
+// Returns nil and no error if the key doesn't exist or has expired (cache miss)
+func (c *Cache[T]) Get(_ context.Context, key string) (*T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.resolve(key, c.lookup)
+	if !ok {
+		return nil, nil
+	}
+	return e.value, nil
+}
+
+// Peek retrieves a value like Get without marking it as recently used
+func (c *Cache[T]) Peek(_ context.Context, key string) (*T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.resolve(key, c.peek)
+	if !ok {
+		return nil, nil
+	}
+	return e.value, nil
+}
+
+// Set stores a value in the cache with the given TTL; a zero TTL never expires
+func (c *Cache[T]) Set(_ context.Context, key string, value *T, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(&entry[T]{key: key, value: value, expiresAt: c.expiry(ttl)})
+	return nil
+}
+
+// Alias makes alias resolve to the value stored under key for the given TTL
+// The alias points past intermediate aliases, so chains don't grow; an alias
+// that would end up pointing at itself is not stored.
+func (c *Cache[T]) Alias(_ context.Context, alias, key string, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.resolve(key, c.peek); ok {
+		key = e.key
+	}
+	if key == alias {
+		return nil
+	}
+
+	c.store(&entry[T]{key: alias, target: key, expiresAt: c.expiry(ttl)})
+	return nil
+}
+
+// List lists the unexpired entries whose keys start with prefix together with the values they resolve to
+// It leaves the usage order untouched.
+func (c *Cache[T]) List(_ context.Context, prefix string) ([]weather.CachedValue[T], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var listed []weather.CachedValue[T]
+	for key := range c.entries {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		e, ok := c.peek(key)
+		if !ok {
+			continue
+		}
+		cached := weather.CachedValue[T]{CacheEntry: c.describe(e)}
+		if v, ok := c.resolve(key, c.peek); ok {
+			cached.Value = v.value
+		}
+		listed = append(listed, cached)
+	}
+	return listed, nil
+}
+
+// Namespace identifies the cache itself; no other cache shares its entries
+func (c *Cache[T]) Namespace() string {
+	return fmt.Sprintf("memory:%p", c)
+}
+
+// Keys lists the unexpired keys starting with prefix
+func (c *Cache[T]) Keys(_ context.Context, prefix string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var keys []string
+	for key := range c.entries {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if _, ok := c.peek(key); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// Inspect describes the entry stored under key without following aliases
+// Returns nil and no error if the key doesn't exist or has expired
+func (c *Cache[T]) Inspect(_ context.Context, key string) (*weather.CacheEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	entry := c.describe(e)
+	return &entry, nil
+}
+
+// Delete removes the given keys and returns how many existed
+func (c *Cache[T]) Delete(_ context.Context, keys ...string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deleted := 0
+	for _, key := range keys {
+		// Expired entries are removed by peek and not counted
+		if _, ok := c.peek(key); ok {
+			c.remove(c.entries[key])
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// Len returns the number of entries, including expired ones not yet evicted
+func (c *Cache[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// lookup finds an unexpired entry and marks it as recently used
+func (c *Cache[T]) lookup(key string) (*entry[T], bool) {
+	e, ok := c.peek(key)
+	if ok {
+		c.order.MoveToFront(c.entries[key])
+	}
+	return e, ok
+}
+
+// peek finds an unexpired entry without changing the usage order
+// Expired entries are removed on sight.
+func (c *Cache[T]) peek(key string) (*entry[T], bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := elem.Value.(*entry[T])
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		c.remove(elem)
+		return nil, false
+	}
+	return e, true
+}
+
+// resolve finds the value key leads to, following aliases with find
+// It fails if a key on the way is missing or the value is more than maxAliasHops aliases away.
+func (c *Cache[T]) resolve(key string, find func(string) (*entry[T], bool)) (*entry[T], bool) {
+	e, ok := find(key)
+	for hops := 0; ok && e.target != ""; hops++ {
+		if hops == maxAliasHops {
+			return nil, false
+		}
+		e, ok = find(e.target)
+	}
+	return e, ok
+}
+
+// describe reports an entry's key, alias target and remaining lifetime
+func (c *Cache[T]) describe(e *entry[T]) weather.CacheEntry {
+	var ttl time.Duration
+	if !e.expiresAt.IsZero() {
+		ttl = e.expiresAt.Sub(c.now())
+	}
+	return weather.CacheEntry{Key: e.key, AliasOf: e.target, TTL: ttl}
+}
+
+// store inserts or replaces an entry, evicting the least recently used one when full
+func (c *Cache[T]) store(e *entry[T]) {
+	if elem, ok := c.entries[e.key]; ok {
+		elem.Value = e
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.maxEntries {
+		c.remove(c.order.Back())
+	}
+	c.entries[e.key] = c.order.PushFront(e)
+}
+
+// remove deletes an entry from both the index and the usage order
+func (c *Cache[T]) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[T]).key)
+}
+
+// expiry converts a TTL to an absolute expiry time; a zero TTL never expires
+func (c *Cache[T]) expiry(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return c.now().Add(ttl)
+}
@@ -0,0 +1,297 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// fakeClock is a controllable time source for expiry tests
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestCache(t *testing.T, maxEntries int) (*Cache[weather.Weather], *fakeClock) {
+	t.Helper()
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)}
+	cache := NewCache[weather.Weather](maxEntries)
+	cache.now = clock.Now
+	return cache, clock
+}
+
+func sampleWeather(name string) *weather.Weather {
+	return &weather.Weather{Location: weather.Location{Name: name}}
+}
+
+func TestCache_SetAndGet(t *testing.T) {
+	cache, _ := newTestCache(t, 10)
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "current:london", sampleWeather("London"), time.Hour)
+	require.NoError(t, err)
+
+	result, err := cache.Get(ctx, "current:london")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "London", result.Location.Name)
+}
+
+func TestCache_Get_NotFound(t *testing.T) {
+	cache, _ := newTestCache(t, 10)
+
+	result, err := cache.Get(context.Background(), "nonexistent")
+
+	// Should return nil, nil for cache miss (not an error)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_TTL(t *testing.T) {
+	cache, clock := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), time.Minute))
+
+	clock.now = clock.now.Add(59 * time.Second)
+	result, err := cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	clock.now = clock.now.Add(time.Second)
+	result, err = cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+	assert.Nil(t, result) // Should be nil (expired)
+	assert.Equal(t, 0, cache.Len(), "expired entries are removed on lookup")
+}
+
+func TestCache_ZeroTTL_NeverExpires(t *testing.T) {
+	cache, clock := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), 0))
+	clock.now = clock.now.Add(365 * 24 * time.Hour)
+
+	result, err := cache.Get(ctx, "current:london")
+
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache, _ := newTestCache(t, 2)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "a", sampleWeather("A"), time.Hour))
+	require.NoError(t, cache.Set(ctx, "b", sampleWeather("B"), time.Hour))
+
+	// Reading "a" makes "b" the least recently used entry
+	_, _ = cache.Get(ctx, "a")
+	require.NoError(t, cache.Set(ctx, "c", sampleWeather("C"), time.Hour))
+
+	a, _ := cache.Get(ctx, "a")
+	b, _ := cache.Get(ctx, "b")
+	c, _ := cache.Get(ctx, "c")
+
+	assert.NotNil(t, a)
+	assert.Nil(t, b)
+	assert.NotNil(t, c)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestCache_Peek_KeepsUsageOrder(t *testing.T) {
+	cache, _ := newTestCache(t, 3)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "a", sampleWeather("A"), time.Hour))
+	require.NoError(t, cache.Set(ctx, "b", sampleWeather("B"), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "alias", "a", time.Hour))
+
+	// Peeking at "a", directly or through an alias, leaves it the least recently used value
+	peeked, err := cache.Peek(ctx, "alias")
+	require.NoError(t, err)
+	require.NotNil(t, peeked)
+	assert.Equal(t, "A", peeked.Location.Name)
+	require.NoError(t, cache.Set(ctx, "c", sampleWeather("C"), time.Hour))
+
+	a, _ := cache.Get(ctx, "a")
+	assert.Nil(t, a)
+}
+
+func TestCache_Alias_TargetLaterAliased(t *testing.T) {
+	cache, _ := newTestCache(t, 10)
+	ctx := context.Background()
+
+	// A name stored directly, with another alias pointing at it
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london,uk", "current:london", time.Hour))
+
+	// The name is later aliased to its canonical coordinates
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", sampleWeather("London"), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", time.Hour))
+
+	result, err := cache.Get(ctx, "current:london,uk")
+
+	require.NoError(t, err)
+	require.NotNil(t, result, "the alias chain is followed")
+	assert.Equal(t, "London", result.Location.Name)
+}
+
+func TestCache_Alias_PointsAtFinalEntry(t *testing.T) {
+	cache, _ := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", sampleWeather("London"), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london,uk", "current:london", time.Hour))
+
+	entry, err := cache.Inspect(ctx, "current:london,uk")
+
+	require.NoError(t, err)
+	assert.Equal(t, "current:51.52,-0.11", entry.AliasOf)
+}
+
+func TestCache_Alias_ToItself_IsIgnored(t *testing.T) {
+	cache, _ := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", sampleWeather("London"), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", time.Hour))
+
+	// Would make the coordinates point at themselves through the name
+	require.NoError(t, cache.Alias(ctx, "current:51.52,-0.11", "current:london", time.Hour))
+
+	result, err := cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "London", result.Location.Name)
+}
+
+func TestCache_Set_ReplacesExistingKey(t *testing.T) {
+	cache, _ := newTestCache(t, 2)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "a", sampleWeather("Old"), time.Hour))
+	require.NoError(t, cache.Set(ctx, "a", sampleWeather("New"), time.Hour))
+
+	result, err := cache.Get(ctx, "a")
+
+	require.NoError(t, err)
+	assert.Equal(t, "New", result.Location.Name)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestCache_Alias(t *testing.T) {
+	cache, clock := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", sampleWeather("London"), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", 24*time.Hour))
+
+	result, err := cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "London", result.Location.Name)
+
+	// The entry expires long before the alias
+	clock.now = clock.now.Add(2 * time.Hour)
+
+	result, err = cache.Get(ctx, "current:london")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_List(t *testing.T) {
+	cache, clock := newTestCache(t, 10)
+	ctx := context.Background()
+
+	london := sampleWeather("London")
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", london, time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", 24*time.Hour))
+	require.NoError(t, cache.Set(ctx, "current:paris", sampleWeather("Paris"), time.Minute))
+	require.NoError(t, cache.Set(ctx, "forecast:london:3", sampleWeather("London"), 0))
+	clock.now = clock.now.Add(time.Minute) // expires current:paris
+
+	listed, err := cache.List(ctx, "current:")
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []weather.CachedValue[weather.Weather]{
+		{CacheEntry: weather.CacheEntry{Key: "current:51.52,-0.11", TTL: 59 * time.Minute}, Value: london},
+		{CacheEntry: weather.CacheEntry{Key: "current:london", AliasOf: "current:51.52,-0.11", TTL: 24*time.Hour - time.Minute}, Value: london},
+	}, listed)
+}
+
+func TestCache_List_KeepsUsageOrder(t *testing.T) {
+	cache, _ := newTestCache(t, 2)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), 0))
+	require.NoError(t, cache.Set(ctx, "current:paris", sampleWeather("Paris"), 0))
+
+	_, err := cache.List(ctx, "current:london")
+	require.NoError(t, err)
+	require.NoError(t, cache.Set(ctx, "current:berlin", sampleWeather("Berlin"), 0))
+
+	// Listing did not mark London as used, so it was evicted first
+	result, err := cache.Get(ctx, "current:london")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_Keys(t *testing.T) {
+	cache, clock := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), time.Hour))
+	require.NoError(t, cache.Set(ctx, "current:paris", sampleWeather("Paris"), time.Minute))
+	require.NoError(t, cache.Set(ctx, "forecast:london:3", sampleWeather("London"), 0))
+	clock.now = clock.now.Add(time.Minute) // expires current:paris
+
+	keys, err := cache.Keys(ctx, "current:")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"current:london"}, keys)
+}
+
+func TestCache_Namespace_IsPerCache(t *testing.T) {
+	assert.NotEqual(t, NewCache[weather.Weather](1).Namespace(), NewCache[weather.Weather](1).Namespace())
+}
+
+func TestCache_Inspect(t *testing.T) {
+	cache, _ := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), 0))
+
+	entry, err := cache.Inspect(ctx, "current:london")
+	require.NoError(t, err)
+	assert.Equal(t, &weather.CacheEntry{Key: "current:london"}, entry)
+
+	entry, err = cache.Inspect(ctx, "current:paris")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestCache_Delete(t *testing.T) {
+	cache, clock := newTestCache(t, 10)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "a", sampleWeather("A"), time.Hour))
+	require.NoError(t, cache.Set(ctx, "b", sampleWeather("B"), time.Minute))
+	clock.now = clock.now.Add(time.Minute) // expires "b"
+
+	deleted, err := cache.Delete(ctx, "a", "b", "missing")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,57 @@
+package nocache
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// Cache implements the cache ports without storing anything
+// It is used when caching is disabled: every Get is a miss and writes are dropped.
+type Cache[T any] struct{}
+
+// Get always reports a cache miss
+func (Cache[T]) Get(context.Context, string) (*T, error) {
+	return nil, nil
+}
+
+// Peek always reports a cache miss
+func (Cache[T]) Peek(context.Context, string) (*T, error) {
+	return nil, nil
+}
+
+// Set discards the value
+func (Cache[T]) Set(context.Context, string, *T, time.Duration) error {
+	return nil
+}
+
+// Alias discards the alias
+func (Cache[T]) Alias(context.Context, string, string, time.Duration) error {
+	return nil
+}
+
+// List lists nothing
+func (Cache[T]) List(context.Context, string) ([]weather.CachedValue[T], error) {
+	return nil, nil
+}
+
+// Namespace identifies the empty store every disabled cache shares
+func (Cache[T]) Namespace() string {
+	return "none"
+}
+
+// Keys lists nothing
+func (Cache[T]) Keys(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+// Inspect always reports a missing key
+func (Cache[T]) Inspect(context.Context, string) (*weather.CacheEntry, error) {
+	return nil, nil
+}
+
+// Delete has nothing to remove
+func (Cache[T]) Delete(context.Context, ...string) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,262 @@
+package redis
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The payload of an envelope is a compact binary encoding of the value: the exported
+// fields of structs in declaration order, without names, as
+//
+//	bool: 1 byte | integers: varint | floats: 8 bytes | strings: length, bytes
+//	pointers: 0 for nil, else 1 and the value | slices: 0 for nil, else length+1 and the elements
+//	time.Time: length, MarshalBinary (keeps the zone offset)
+//
+// Since fields are positional, every envelope carries a fingerprint of the type's
+// layout; renaming, reordering or retyping a field changes it, and entries written
+// with another layout read as misses. encoding/gob would avoid that, but it repeats
+// its type descriptors in every value, making small values larger than JSON.
+
+// errMalformed indicates a payload that doesn't decode as the expected type
+var errMalformed = errors.New("malformed cache payload")
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// fingerprints caches the layout fingerprint of each type
+var fingerprints sync.Map // reflect.Type -> uint32
+
+// layoutFingerprint hashes the names, order and types of the fields making up t
+func layoutFingerprint(t reflect.Type) uint32 {
+	if fp, ok := fingerprints.Load(t); ok {
+		return fp.(uint32)
+	}
+
+	var layout strings.Builder
+	describeLayout(&layout, t, map[reflect.Type]bool{})
+	h := fnv.New32a()
+	h.Write([]byte(layout.String()))
+	fp := h.Sum32()
+
+	fingerprints.Store(t, fp)
+	return fp
+}
+
+// describeLayout writes a canonical description of the encoded layout of t
+func describeLayout(b *strings.Builder, t reflect.Type, visiting map[reflect.Type]bool) {
+	switch {
+	case t == timeType:
+		b.WriteString("time")
+	case t.Kind() == reflect.Pointer:
+		b.WriteString("*")
+		describeLayout(b, t.Elem(), visiting)
+	case t.Kind() == reflect.Slice:
+		b.WriteString("[]")
+		describeLayout(b, t.Elem(), visiting)
+	case t.Kind() == reflect.Struct:
+		if visiting[t] {
+			// Recursive types refer back to themselves by name
+			b.WriteString(t.Name())
+			return
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		b.WriteString(t.Name() + "{")
+		for i := range t.NumField() {
+			if f := t.Field(i); f.IsExported() {
+				b.WriteString(f.Name + " ")
+				describeLayout(b, f.Type, visiting)
+				b.WriteString(";")
+			}
+		}
+		b.WriteString("}")
+	default:
+		b.WriteString(t.Kind().String())
+	}
+}
+
+// appendValue appends the binary encoding of v to buf
+func appendValue(buf []byte, v reflect.Value) ([]byte, error) {
+	if v.Type() == timeType {
+		data, err := v.Interface().(time.Time).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(data)))
+		return append(buf, data...), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(buf, v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return binary.AppendUvarint(buf, v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return binary.BigEndian.AppendUint64(buf, math.Float64bits(v.Float())), nil
+	case reflect.String:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		return append(buf, v.String()...), nil
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+		return appendValue(append(buf, 1), v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+		buf = binary.AppendUvarint(buf, uint64(v.Len())+1)
+		for i := range v.Len() {
+			var err error
+			if buf, err = appendValue(buf, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			var err error
+			if buf, err = appendValue(buf, v.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	default:
+		return nil, fmt.Errorf("cannot encode %s values", v.Type())
+	}
+}
+
+// payloadReader decodes a binary payload
+type payloadReader struct {
+	data []byte
+}
+
+// readValue decodes the next value of the payload into v
+func (r *payloadReader) readValue(v reflect.Value) error {
+	if v.Type() == timeType {
+		data, err := r.readBytes()
+		if err != nil {
+			return err
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary(data); err != nil {
+			return errMalformed
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := r.readByte()
+		v.SetBool(b == 1)
+		return err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, size := binary.Varint(r.data)
+		if size <= 0 {
+			return errMalformed
+		}
+		r.data = r.data[size:]
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := r.readUvarint()
+		v.SetUint(n)
+		return err
+	case reflect.Float32, reflect.Float64:
+		if len(r.data) < 8 {
+			return errMalformed
+		}
+		v.SetFloat(math.Float64frombits(binary.BigEndian.Uint64(r.data)))
+		r.data = r.data[8:]
+		return nil
+	case reflect.String:
+		data, err := r.readBytes()
+		v.SetString(string(data))
+		return err
+	case reflect.Pointer:
+		present, err := r.readByte()
+		if err != nil || present == 0 {
+			return err
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return r.readValue(v.Elem())
+	case reflect.Slice:
+		n, err := r.readUvarint()
+		if err != nil || n == 0 {
+			return err
+		}
+		// Every element takes at least one byte, which bounds corrupted lengths
+		if n-1 > uint64(len(r.data)) {
+			return errMalformed
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(n-1), int(n-1)))
+		for i := range v.Len() {
+			if err := r.readValue(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := r.readValue(v.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot decode %s values", v.Type())
+	}
+}
+
+// readByte reads a single byte
+func (r *payloadReader) readByte() (byte, error) {
+	if len(r.data) == 0 {
+		return 0, errMalformed
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b, nil
+}
+
+// readUvarint reads an unsigned varint
+func (r *payloadReader) readUvarint() (uint64, error) {
+	n, size := binary.Uvarint(r.data)
+	if size <= 0 {
+		return 0, errMalformed
+	}
+	r.data = r.data[size:]
+	return n, nil
+}
+
+// readBytes reads a length-prefixed byte string
+func (r *payloadReader) readBytes() ([]byte, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.data)) {
+		return nil, errMalformed
+	}
+	data := r.data[:n]
+	r.data = r.data[n:]
+	return data, nil
+}
@@ -2,8 +2,12 @@ package redis
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,65 +15,369 @@ import (
 	"weather-api-wrapper/internal/domain/weather"
 )
 
+// keyPrefix namespaces every key written by this service, so the Redis
+// instance can be shared and the layout can be versioned
+const keyPrefix = "weather:v1:"
+
+// scanBatchSize is the number of keys Redis examines per SCAN round-trip
+const scanBatchSize = 100
+
+// globEscaper escapes the characters SCAN MATCH patterns give a special meaning
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// aliasMarker starts the value of an alias key; envelopes never start with it
+const aliasMarker = "@"
+
+// maxAliasHops bounds how many aliases are followed to reach an entry
+// Aliases are written pointing at an entry, but that entry may later be replaced
+// by an alias itself; the bound turns an accidental cycle into a cache miss.
+const maxAliasHops = 4
+
 // Cache implements the WeatherCache port using Redis
+// Redis being down is not an error: the cache is bypassed until it is back.
 type Cache struct {
-	client *redis.Client
+	conn  *conn
+	codec codec
+}
+
+// Option configures how a Redis cache encodes values
+type Option func(*codec)
+
+// WithProvider records the name of the provider the cached data came from in each entry
+func WithProvider(name string) Option {
+	return func(c *codec) {
+		c.provider = name
+	}
+}
+
+// WithCompression compresses large values before storing them
+func WithCompression(enabled bool) Option {
+	return func(c *codec) {
+		c.compress = enabled
+	}
 }
 
 // NewCache creates a new Redis cache adapter
-func NewCache(host string, port string) (*Cache, error) {
+// It does not require Redis to be reachable; connection failures are logged
+// and the cache is bypassed until a background health check succeeds.
+func NewCache(host string, port string, opts ...Option) *Cache {
 	client := redis.NewClient(&redis.Options{
 		Addr: fmt.Sprintf("%s:%s", host, port),
 	})
 
-	// Verify connection
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := client.Ping(ctx).Err(); err != nil {
-		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	c := newCodec()
+	for _, opt := range opts {
+		opt(&c)
 	}
 
 	return &Cache{
-		client: client,
-	}, nil
+		conn:  newConn(client, healthCheckInterval),
+		codec: c,
+	}
 }
 
-// Get retrieves weather data from Redis cache
+// Available reports whether Redis is currently reachable
+func (c *Cache) Available() bool {
+	return c.conn.available()
+}
+
+// Get retrieves weather data from Redis cache, following an alias to the entry it points to
 // Returns nil and no error if the key doesn't exist (cache miss)
-func (c *Cache) Get(ctx context.Context, location string) (*weather.Weather, error) {
-	data, err := c.client.Get(ctx, location).Result()
+func (c *Cache) Get(ctx context.Context, key string) (*weather.Weather, error) {
+	return getValue[weather.Weather](ctx, c.conn, key)
+}
+
+// Peek retrieves weather data like Get; Redis keeps no bookkeeping reads could disturb
+func (c *Cache) Peek(ctx context.Context, key string) (*weather.Weather, error) {
+	return c.Get(ctx, key)
+}
+
+// Set stores weather data in Redis cache with the given TTL
+func (c *Cache) Set(ctx context.Context, key string, data *weather.Weather, ttl time.Duration) error {
+	return setValue(ctx, c.conn, c.codec, key, data, ttl)
+}
+
+// Alias makes alias resolve to the entry stored under key for the given TTL
+func (c *Cache) Alias(ctx context.Context, alias, key string, ttl time.Duration) error {
+	return setAlias(ctx, c.conn, alias, key, ttl)
+}
+
+// List lists the entries whose keys start with prefix together with the weather data they resolve to
+func (c *Cache) List(ctx context.Context, prefix string) ([]weather.CachedWeather, error) {
+	return listValues[weather.Weather](ctx, c.conn, prefix)
+}
+
+// Namespace identifies the Redis database and key namespace, shared with every Store of the cache
+func (c *Cache) Namespace() string {
+	return c.conn.namespace()
+}
+
+// Keys lists the keys starting with prefix, across every data type
+func (c *Cache) Keys(ctx context.Context, prefix string) ([]string, error) {
+	return scanKeys(ctx, c.conn, prefix)
+}
+
+// Inspect describes the entry stored under key without following aliases
+// Returns nil and no error if the key doesn't exist
+func (c *Cache) Inspect(ctx context.Context, key string) (*weather.CacheEntry, error) {
+	return inspectEntry(ctx, c.conn, key)
+}
+
+// Delete removes the given keys and returns how many existed
+func (c *Cache) Delete(ctx context.Context, keys ...string) (int, error) {
+	return deleteKeys(ctx, c.conn, keys)
+}
+
+// Close stops the health checks and closes the Redis client connection
+func (c *Cache) Close() error {
+	return c.conn.close()
+}
+
+// getRaw reads the value of a namespaced key
+// Returns nil and no error if the key doesn't exist (cache miss) or Redis is unavailable
+func getRaw(ctx context.Context, c *conn, key string) (*string, error) {
+	if !c.available() {
+		return nil, nil
+	}
+
+	data, err := c.client.Get(ctx, keyPrefix+key).Result()
 	if err != nil {
 		// redis.Nil indicates the key doesn't exist (cache miss)
 		if err == redis.Nil {
 			return nil, nil
 		}
+		c.observe(ctx, err)
 		return nil, fmt.Errorf("failed to get from cache: %w", err)
 	}
+	return &data, nil
+}
 
-	var weatherData weather.Weather
-	if err := json.Unmarshal([]byte(data), &weatherData); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cached data: %w", err)
+// getValue reads a key and decodes its value into a new T
+// An alias key is followed to the entry it points to.
+// Returns nil and no error if the key doesn't exist (cache miss), or holds a
+// value written in another format or schema version, which is then replaced
+// on the next write.
+func getValue[T any](ctx context.Context, c *conn, key string) (*T, error) {
+	key, data, err := resolve(ctx, c, key)
+	if err != nil || data == nil {
+		return nil, err
 	}
 
-	return &weatherData, nil
+	value, _, err := decode[T]([]byte(*data))
+	if errors.Is(err, errIncompatible) {
+		log.Printf("Ignoring incompatible cache entry %s: %v", key, err)
+		return nil, nil
+	}
+	return value, err
 }
 
-// Set stores weather data in Redis cache with the given TTL
-func (c *Cache) Set(ctx context.Context, location string, data *weather.Weather, ttl time.Duration) error {
-	jsonData, err := json.Marshal(data)
+// resolve follows aliases from key to the entry they lead to, and returns its key and raw value
+// Returns a nil value and no error if a key on the way doesn't exist, or the
+// entry is more than maxAliasHops aliases away.
+func resolve(ctx context.Context, c *conn, key string) (string, *string, error) {
+	for hops := 0; ; hops++ {
+		data, err := getRaw(ctx, c, key)
+		if err != nil || data == nil {
+			return key, nil, err
+		}
+		target, ok := strings.CutPrefix(*data, aliasMarker)
+		if !ok {
+			return key, data, nil
+		}
+		if hops == maxAliasHops {
+			log.Printf("Ignoring cache alias chain longer than %d hops at %s", maxAliasHops, key)
+			return key, nil, nil
+		}
+		key = target
+	}
+}
+
+// setAlias stores an alias under the namespaced alias key, pointing at the entry key leads to
+// Pointing past intermediate aliases keeps chains from growing. An alias that
+// would end up pointing at itself is not stored.
+// The alias is dropped while Redis is unavailable
+func setAlias(ctx context.Context, c *conn, alias, key string, ttl time.Duration) error {
+	if !c.available() {
+		return nil
+	}
+
+	key, _, err := resolve(ctx, c, key)
 	if err != nil {
-		return fmt.Errorf("failed to marshal weather data: %w", err)
+		return fmt.Errorf("failed to set cache alias: %w", err)
+	}
+	if key == alias {
+		return nil
 	}
 
-	if err := c.client.Set(ctx, location, jsonData, ttl).Err(); err != nil {
+	if err := c.client.Set(ctx, keyPrefix+alias, aliasMarker+key, ttl).Err(); err != nil {
+		c.observe(ctx, err)
+		return fmt.Errorf("failed to set cache alias: %w", err)
+	}
+	return nil
+}
+
+// setValue encodes value and stores it under the namespaced key with the given TTL
+// The value is dropped while Redis is unavailable
+func setValue[T any](ctx context.Context, c *conn, cd codec, key string, value *T, ttl time.Duration) error {
+	if !c.available() {
+		return nil
+	}
+
+	data, err := encode(cd, value)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.Set(ctx, keyPrefix+key, data, ttl).Err(); err != nil {
+		c.observe(ctx, err)
 		return fmt.Errorf("failed to set cache: %w", err)
 	}
 
 	return nil
 }
 
-// Close closes the Redis client connection
-func (c *Cache) Close() error {
-	return c.client.Close()
+// scanKeys lists the namespaced keys starting with prefix
+// Unlike reads and writes it is not bypassed while Redis is unavailable, so
+// administrators see the failure instead of an empty cache.
+func scanKeys(ctx context.Context, c *conn, prefix string) ([]string, error) {
+	var keys []string
+	iter := c.client.Scan(ctx, 0, keyPrefix+globEscaper.Replace(prefix)+"*", scanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, strings.TrimPrefix(iter.Val(), keyPrefix))
+	}
+	if err := iter.Err(); err != nil {
+		c.observe(ctx, err)
+		return nil, fmt.Errorf("failed to scan cache: %w", err)
+	}
+	return keys, nil
+}
+
+// listValues lists the namespaced entries whose keys start with prefix together with the values they resolve to
+// Entries and their values are read in one round-trip, and the targets of
+// aliases the listing doesn't cover in one more. Values of another data type,
+// or written in another format or schema version, are left nil.
+func listValues[T any](ctx context.Context, c *conn, prefix string) ([]weather.CachedValue[T], error) {
+	keys, err := scanKeys(ctx, c, prefix)
+	if err != nil {
+		return nil, err
+	}
+	entries, raw, err := readKeys(ctx, c, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []string
+	for _, entry := range entries {
+		if _, ok := raw[entry.AliasOf]; entry.AliasOf != "" && !ok && !slices.Contains(targets, entry.AliasOf) {
+			targets = append(targets, entry.AliasOf)
+		}
+	}
+	if len(targets) > 0 {
+		_, more, err := readKeys(ctx, c, targets)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(raw, more)
+	}
+
+	listed := make([]weather.CachedValue[T], len(entries))
+	for i, entry := range entries {
+		listed[i].CacheEntry = entry
+		key := entry.Key
+		if entry.AliasOf != "" {
+			key = entry.AliasOf
+		}
+		data, ok := raw[key]
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(data, aliasMarker) {
+			// The target was replaced by an alias after this one was written
+			if listed[i].Value, err = getValue[T](ctx, c, entry.Key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		value, _, err := decode[T]([]byte(data))
+		if err != nil && !errors.Is(err, errIncompatible) {
+			return nil, err
+		}
+		listed[i].Value = value
+	}
+	return listed, nil
+}
+
+// inspectEntry describes a single namespaced entry
+// Returns nil and no error if the key doesn't exist
+func inspectEntry(ctx context.Context, c *conn, key string) (*weather.CacheEntry, error) {
+	entries, _, err := readKeys(ctx, c, []string{key})
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+// readKeys describes namespaced keys and reads their raw values, by key, in one round-trip
+// Keys that no longer exist are left out.
+func readKeys(ctx context.Context, c *conn, keys []string) ([]weather.CacheEntry, map[string]string, error) {
+	if len(keys) == 0 {
+		return nil, map[string]string{}, nil
+	}
+
+	pipe := c.client.Pipeline()
+	values := make([]*redis.StringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		values[i] = pipe.Get(ctx, keyPrefix+key)
+		ttls[i] = pipe.PTTL(ctx, keyPrefix+key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		c.observe(ctx, err)
+		return nil, nil, fmt.Errorf("failed to inspect cache: %w", err)
+	}
+
+	entries := make([]weather.CacheEntry, 0, len(keys))
+	raw := make(map[string]string, len(keys))
+	for i, key := range keys {
+		value, err := values[i].Result()
+		if err != nil {
+			// Expired or deleted since it was listed
+			continue
+		}
+		raw[key] = value
+
+		entry := weather.CacheEntry{Key: key}
+		if target, ok := strings.CutPrefix(value, aliasMarker); ok {
+			entry.AliasOf = target
+		} else if env, _, err := readEnvelope([]byte(value)); err == nil {
+			entry.StoredAt = env.storedAt
+			entry.Provider = env.provider
+		}
+		// PTTL is negative for keys without an expiry
+		if ttl := ttls[i].Val(); ttl > 0 {
+			entry.TTL = ttl
+		}
+		entries = append(entries, entry)
+	}
+	return entries, raw, nil
+}
+
+// deleteKeys removes namespaced keys and returns how many existed
+func deleteKeys(ctx context.Context, c *conn, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	namespaced := make([]string, len(keys))
+	for i, key := range keys {
+		namespaced[i] = keyPrefix + key
+	}
+
+	deleted, err := c.client.Del(ctx, namespaced...).Result()
+	if err != nil {
+		c.observe(ctx, err)
+		return 0, fmt.Errorf("failed to delete from cache: %w", err)
+	}
+	return int(deleted), nil
 }
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"testing"
 	"time"
@@ -20,9 +21,10 @@ func setupTestRedis(t *testing.T) (*miniredis.Miniredis, *Cache) {
 
 	// Create Redis client connected to miniredis
 	cache := &Cache{
-		client: goredis.NewClient(&goredis.Options{
+		conn: newConn(goredis.NewClient(&goredis.Options{
 			Addr: mr.Addr(),
-		}),
+		}), time.Hour),
+		codec: newCodec(),
 	}
 
 	t.Cleanup(func() {
@@ -66,11 +68,10 @@ func TestCache_Set(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify data was stored in Redis
-	data, err := mr.Get(location)
+	data, err := mr.Get(keyPrefix + location)
 	require.NoError(t, err)
 
-	var stored weather.Weather
-	err = json.Unmarshal([]byte(data), &stored)
+	stored, _, err := decode[weather.Weather]([]byte(data))
 	require.NoError(t, err)
 
 	assert.Equal(t, weatherData.Location.Name, stored.Location.Name)
@@ -85,9 +86,9 @@ func TestCache_Get(t *testing.T) {
 	location := "London"
 
 	// Pre-populate cache
-	data, err := json.Marshal(weatherData)
+	data, err := encode(newCodec(), weatherData)
 	require.NoError(t, err)
-	mr.Set(location, string(data))
+	mr.Set(keyPrefix+location, string(data))
 
 	result, err := cache.Get(ctx, location)
 
@@ -109,11 +110,43 @@ func TestCache_Get_NotFound(t *testing.T) {
 	assert.Nil(t, result)
 }
 
-func TestCache_Get_InvalidJSON(t *testing.T) {
+func TestCache_Get_LegacyJSON_IsMiss(t *testing.T) {
 	mr, cache := setupTestRedis(t)
 	ctx := context.Background()
 
-	mr.Set("London", "not valid json")
+	// Values written before envelopes were introduced
+	data, err := json.Marshal(createSampleWeather())
+	require.NoError(t, err)
+	mr.Set(keyPrefix+"London", string(data))
+
+	result, err := cache.Get(ctx, "London")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_Get_OtherSchemaVersion_IsMiss(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	data, err := encode(newCodec(), createSampleWeather())
+	require.NoError(t, err)
+	binary.BigEndian.PutUint16(data[1:3], schemaVersion+1)
+	mr.Set(keyPrefix+"London", string(data))
+
+	result, err := cache.Get(ctx, "London")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_Get_CorruptedValue(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	data, err := encode(newCodec(), createSampleWeather())
+	require.NoError(t, err)
+	mr.Set(keyPrefix+"London", string(data[:len(data)-10]))
 
 	result, err := cache.Get(ctx, "London")
 
@@ -164,3 +197,214 @@ func TestCache_TTL(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, result) // Should be nil (expired)
 }
+
+func TestCache_Alias(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	weatherData := createSampleWeather()
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", weatherData, time.Hour))
+
+	err := cache.Alias(ctx, "current:london", "current:51.52,-0.11", 24*time.Hour)
+	require.NoError(t, err)
+
+	// The alias is stored under the namespaced key and points at the entry
+	raw, err := mr.Get(keyPrefix + "current:london")
+	require.NoError(t, err)
+	assert.Equal(t, aliasMarker+"current:51.52,-0.11", raw)
+
+	result, err := cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, weatherData.Location.Name, result.Location.Name)
+}
+
+func TestCache_Alias_TargetExpired(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", createSampleWeather(), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", 24*time.Hour))
+
+	// The entry expires long before the alias
+	mr.FastForward(2 * time.Hour)
+
+	result, err := cache.Get(ctx, "current:london")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_Alias_TargetLaterAliased(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	// A name stored directly, with another alias pointing at it
+	require.NoError(t, cache.Set(ctx, "current:london", createSampleWeather(), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london,uk", "current:london", time.Hour))
+
+	// The name is later aliased to its canonical coordinates
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", createSampleWeather(), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", time.Hour))
+
+	result, err := cache.Get(ctx, "current:london,uk")
+
+	require.NoError(t, err)
+	require.NotNil(t, result, "the alias chain is followed")
+	assert.Equal(t, "London", result.Location.Name)
+}
+
+func TestCache_Alias_PointsAtFinalEntry(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", createSampleWeather(), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", time.Hour))
+
+	err := cache.Alias(ctx, "current:london,uk", "current:london", time.Hour)
+
+	require.NoError(t, err)
+	raw, err := mr.Get(keyPrefix + "current:london,uk")
+	require.NoError(t, err)
+	assert.Equal(t, aliasMarker+"current:51.52,-0.11", raw)
+}
+
+func TestCache_Alias_Cycle_IsMiss(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, mr.Set(keyPrefix+"current:a", aliasMarker+"current:b"))
+	require.NoError(t, mr.Set(keyPrefix+"current:b", aliasMarker+"current:a"))
+
+	result, err := cache.Get(ctx, "current:a")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_List(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", createSampleWeather(), time.Hour))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", 24*time.Hour))
+	require.NoError(t, cache.Set(ctx, "forecast:london:3", createSampleWeather(), 0))
+	// Keys outside the namespace are never listed
+	require.NoError(t, mr.Set("current:other-service", "value"))
+
+	listed, err := cache.List(ctx, "current:")
+
+	require.NoError(t, err)
+	entries := make([]weather.CacheEntry, len(listed))
+	for i, cached := range listed {
+		require.NotNil(t, cached.Value, cached.Key)
+		assert.Equal(t, "London", cached.Value.Location.Name)
+		entries[i] = cached.CacheEntry
+		entries[i].StoredAt = time.Time{}
+	}
+	assert.ElementsMatch(t, []weather.CacheEntry{
+		{Key: "current:51.52,-0.11", TTL: time.Hour},
+		{Key: "current:london", AliasOf: "current:51.52,-0.11", TTL: 24 * time.Hour},
+	}, entries)
+}
+
+func TestCache_List_ReadsAliasTargetsOutsidePrefix(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", createSampleWeather(), 0))
+	require.NoError(t, cache.Alias(ctx, "current:london", "current:51.52,-0.11", 0))
+	require.NoError(t, cache.Alias(ctx, "current:paris", "current:48.86,2.35", 0))
+
+	listed, err := cache.List(ctx, "current:l")
+
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.NotNil(t, listed[0].Value)
+	assert.Equal(t, "London", listed[0].Value.Location.Name)
+
+	// An alias whose entry is gone is listed without data
+	listed, err = cache.List(ctx, "current:p")
+
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "current:48.86,2.35", listed[0].AliasOf)
+	assert.Nil(t, listed[0].Value)
+}
+
+func TestCache_List_ChainedAlias(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", createSampleWeather(), 0))
+	require.NoError(t, mr.Set(keyPrefix+"current:a", aliasMarker+"current:b"))
+	require.NoError(t, mr.Set(keyPrefix+"current:b", aliasMarker+"current:51.52,-0.11"))
+
+	listed, err := cache.List(ctx, "current:a")
+
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.NotNil(t, listed[0].Value)
+	assert.Equal(t, "London", listed[0].Value.Location.Name)
+}
+
+func TestCache_Keys_EscapesPattern(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "search:lo*", createSampleWeather(), 0))
+	require.NoError(t, cache.Set(ctx, "search:london", createSampleWeather(), 0))
+
+	keys, err := cache.Keys(ctx, "search:lo*")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"search:lo*"}, keys)
+}
+
+func TestCache_Keys_DoesNotReadValues(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", createSampleWeather(), 0))
+	before := mr.CommandCount()
+
+	keys, err := cache.Keys(ctx, "current:")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"current:london"}, keys)
+	// One SCAN covers this small keyspace
+	assert.Equal(t, 1, mr.CommandCount()-before)
+}
+
+func TestCache_Inspect(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", createSampleWeather(), 0))
+
+	entry, err := cache.Inspect(ctx, "current:london")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, "current:london", entry.Key)
+	assert.Zero(t, entry.TTL)
+	assert.WithinDuration(t, time.Now(), entry.StoredAt, time.Second)
+
+	entry, err = cache.Inspect(ctx, "current:paris")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestCache_Delete(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:london", createSampleWeather(), time.Hour))
+	require.NoError(t, cache.Set(ctx, "current:paris", createSampleWeather(), time.Hour))
+
+	deleted, err := cache.Delete(ctx, "current:london", "current:missing")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.False(t, mr.Exists(keyPrefix+"current:london"))
+	assert.True(t, mr.Exists(keyPrefix+"current:paris"))
+}
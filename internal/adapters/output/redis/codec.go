@@ -0,0 +1,147 @@
+package redis
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+)
+
+// schemaVersion identifies the meaning of cached values
+// Layout changes to the domain types are detected on their own (see binary.go);
+// bump it when the meaning of a field changes without its layout, such as a
+// change of unit. Entries written with another version are treated as cache
+// misses and replaced.
+const schemaVersion uint16 = 1
+
+// envelopeMarker starts every cached value, telling it apart from aliases and from
+// the JSON values written before envelopes were introduced
+const envelopeMarker byte = 0xCA
+
+// Envelope flags
+const (
+	flagCompressed byte = 1 << iota
+)
+
+// compressMinSize is the smallest payload worth compressing
+const compressMinSize = 256
+
+// headerSize is the size of the fixed part of the envelope header:
+// marker, schema version, layout fingerprint, flags, stored-at time and provider name length
+const headerSize = 1 + 2 + 4 + 1 + 8 + 1
+
+// errIncompatible indicates a cached value written in another format, schema version or layout
+var errIncompatible = errors.New("incompatible cache entry")
+
+// envelope describes a cached value
+type envelope struct {
+	version     uint16
+	fingerprint uint32
+	flags       byte
+	storedAt    time.Time
+	provider    string
+}
+
+// codec encodes values into versioned envelopes:
+//
+//	marker (1) | schema version (2) | layout fingerprint (4) | flags (1) |
+//	stored at, Unix ms (8) | provider length (1) | provider | payload
+//
+// The payload is deflated when compression is enabled and it is large enough.
+type codec struct {
+	provider string
+	compress bool
+	now      func() time.Time
+}
+
+// newCodec creates a codec with the default settings
+func newCodec() codec {
+	return codec{now: time.Now}
+}
+
+// encode wraps a value in an envelope
+func encode[T any](c codec, value *T) ([]byte, error) {
+	payload, err := appendValue(nil, reflect.ValueOf(value).Elem())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cache data: %w", err)
+	}
+
+	var flags byte
+	if c.compress && len(payload) >= compressMinSize {
+		var compressed bytes.Buffer
+		w, _ := flate.NewWriter(&compressed, flate.BestSpeed) // only fails on an invalid level
+		if _, err := w.Write(payload); err != nil {
+			return nil, fmt.Errorf("failed to compress cache data: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress cache data: %w", err)
+		}
+		flags |= flagCompressed
+		payload = compressed.Bytes()
+	}
+
+	provider := c.provider[:min(len(c.provider), 255)]
+	data := make([]byte, 0, headerSize+len(provider)+len(payload))
+	data = append(data, envelopeMarker)
+	data = binary.BigEndian.AppendUint16(data, schemaVersion)
+	data = binary.BigEndian.AppendUint32(data, layoutFingerprint(reflect.TypeFor[T]()))
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint64(data, uint64(c.now().UnixMilli()))
+	data = append(data, byte(len(provider)))
+	data = append(data, provider...)
+	return append(data, payload...), nil
+}
+
+// decode unwraps a value from its envelope
+// Values written in another format, schema version or layout fail with errIncompatible.
+func decode[T any](data []byte) (*T, envelope, error) {
+	env, payload, err := readEnvelope(data)
+	if err != nil {
+		return nil, env, err
+	}
+	if want := layoutFingerprint(reflect.TypeFor[T]()); env.fingerprint != want {
+		return nil, env, fmt.Errorf("%w: layout %08x, want %08x", errIncompatible, env.fingerprint, want)
+	}
+
+	if env.flags&flagCompressed != 0 {
+		if payload, err = io.ReadAll(flate.NewReader(bytes.NewReader(payload))); err != nil {
+			return nil, env, fmt.Errorf("failed to decompress cached data: %w", err)
+		}
+	}
+
+	var value T
+	r := &payloadReader{data: payload}
+	if err := r.readValue(reflect.ValueOf(&value).Elem()); err != nil {
+		return nil, env, fmt.Errorf("failed to decode cached data: %w", err)
+	}
+	if len(r.data) != 0 {
+		return nil, env, fmt.Errorf("failed to decode cached data: %w", errMalformed)
+	}
+	return &value, env, nil
+}
+
+// readEnvelope parses the envelope header and returns it with the payload
+func readEnvelope(data []byte) (envelope, []byte, error) {
+	if len(data) < headerSize || data[0] != envelopeMarker {
+		return envelope{}, nil, errIncompatible
+	}
+
+	env := envelope{version: binary.BigEndian.Uint16(data[1:3])}
+	if env.version != schemaVersion {
+		return env, nil, fmt.Errorf("%w: schema version %d, want %d", errIncompatible, env.version, schemaVersion)
+	}
+
+	env.fingerprint = binary.BigEndian.Uint32(data[3:7])
+	env.flags = data[7]
+	env.storedAt = time.UnixMilli(int64(binary.BigEndian.Uint64(data[8:16]))).UTC()
+	providerEnd := headerSize + int(data[16])
+	if len(data) < providerEnd {
+		return env, nil, fmt.Errorf("failed to decode cached data: %w", errMalformed)
+	}
+	env.provider = string(data[headerSize:providerEnd])
+	return env, data[providerEnd:], nil
+}
@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func testCodec(compress bool) codec {
+	storedAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	return codec{
+		provider: "weatherapi.com",
+		compress: compress,
+		now:      func() time.Time { return storedAt },
+	}
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		cd := testCodec(compress)
+		forecast := &weather.Forecast{
+			Location: weather.Location{Name: "Athens", Latitude: 37.98, Longitude: 23.73},
+			Days: []weather.DailyWeather{
+				{MaxTemp: weather.TemperatureValue{Celsius: 18.2}, ChanceOfRain: 80},
+				{MaxTemp: weather.TemperatureValue{Celsius: 21.5}, ChanceOfRain: 10},
+			},
+		}
+
+		data, err := encode(cd, forecast)
+		require.NoError(t, err)
+
+		result, env, err := decode[weather.Forecast](data)
+
+		require.NoError(t, err)
+		assert.Equal(t, forecast, result)
+		assert.Equal(t, schemaVersion, env.version)
+		assert.Equal(t, cd.now(), env.storedAt)
+		assert.Equal(t, "weatherapi.com", env.provider)
+	}
+}
+
+func TestCodec_CompressesLargeValues(t *testing.T) {
+	value := &weather.Weather{Location: weather.Location{Name: strings.Repeat("Llanfairpwllgwyngyll", 50)}}
+
+	plain, err := encode(testCodec(false), value)
+	require.NoError(t, err)
+	compressed, err := encode(testCodec(true), value)
+	require.NoError(t, err)
+
+	assert.Less(t, len(compressed), len(plain)/2)
+	assert.NotZero(t, compressed[7]&flagCompressed)
+}
+
+func TestCodec_SmallerThanJSON(t *testing.T) {
+	value := createSampleWeather()
+
+	data, err := encode(testCodec(false), value)
+	require.NoError(t, err)
+	jsonData, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	assert.Less(t, len(data), len(jsonData))
+}
+
+func TestCodec_RejectsOtherFormats(t *testing.T) {
+	for _, data := range []string{"", "{\"Location\":{}}", "@current:london", "\xca\x00"} {
+		_, _, err := decode[weather.Weather]([]byte(data))
+
+		assert.ErrorIs(t, err, errIncompatible, "value %q", data)
+	}
+}
+
+func TestCodec_RoundTrip_TimesAndPointers(t *testing.T) {
+	athens := time.FixedZone("EET", 2*60*60)
+	value := &weather.Weather{
+		Location: weather.Location{Name: "Athens", Latitude: 37.98, Longitude: 23.73},
+		Current: weather.CurrentWeather{
+			LastUpdated: time.Date(2026, 3, 2, 14, 15, 0, 0, athens),
+			AirQuality:  &weather.AirQuality{PM2_5: 12.4, USEPAIndex: 1},
+		},
+		UpdatedAt:  time.Date(2026, 3, 2, 12, 20, 0, 123456789, time.UTC),
+		FreshUntil: time.Date(2026, 3, 2, 12, 30, 0, 0, time.UTC),
+	}
+
+	data, err := encode(testCodec(false), value)
+	require.NoError(t, err)
+	result, _, err := decode[weather.Weather](data)
+
+	require.NoError(t, err)
+	assert.Equal(t, value.Current.AirQuality, result.Current.AirQuality)
+	assert.True(t, value.Current.LastUpdated.Equal(result.Current.LastUpdated))
+	_, offset := result.Current.LastUpdated.Zone()
+	assert.Equal(t, 2*60*60, offset, "the zone offset is kept")
+	assert.True(t, value.UpdatedAt.Equal(result.UpdatedAt))
+}
+
+func TestCodec_OtherLayout_IsIncompatible(t *testing.T) {
+	data, err := encode(testCodec(false), &weather.Forecast{Location: weather.Location{Name: "Athens"}})
+	require.NoError(t, err)
+
+	_, _, err = decode[weather.History](data)
+
+	assert.ErrorIs(t, err, errIncompatible)
+}
+
+func TestCodec_TruncatedPayload(t *testing.T) {
+	data, err := encode(testCodec(false), createSampleWeather())
+	require.NoError(t, err)
+
+	_, _, err = decode[weather.Weather](data[:len(data)-3])
+
+	assert.ErrorIs(t, err, errMalformed)
+}
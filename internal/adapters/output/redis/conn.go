@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Health check settings
+const (
+	healthCheckInterval = 5 * time.Second
+	healthCheckTimeout  = 2 * time.Second
+)
+
+// conn is a Redis client shared by a Cache and its Stores, together with its health
+// While Redis is unreachable the cache is bypassed: reads miss and writes are
+// dropped. A background check pings Redis and resumes caching once it answers;
+// the client itself reconnects on demand.
+type conn struct {
+	client    *redis.Client
+	unhealthy atomic.Bool
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+// newConn wraps a client and starts checking its health every interval
+func newConn(client *redis.Client, interval time.Duration) *conn {
+	c := &conn{
+		client: client,
+		stop:   make(chan struct{}),
+	}
+	c.checkHealth()
+	go c.monitor(interval)
+	return c
+}
+
+// available reports whether Redis is believed reachable
+func (c *conn) available() bool {
+	return !c.unhealthy.Load()
+}
+
+// namespace identifies the Redis database the client talks to, and the key namespace used on it
+func (c *conn) namespace() string {
+	opts := c.client.Options()
+	return fmt.Sprintf("redis://%s/%d/%s", opts.Addr, opts.DB, keyPrefix)
+}
+
+// observe marks Redis unhealthy when a command made with ctx failed for reasons other than a missing key
+// The caller giving up, cancelled or past its own deadline, says nothing about Redis, so it is ignored.
+func (c *conn) observe(ctx context.Context, err error) {
+	if err == nil || errors.Is(err, redis.Nil) || errors.Is(err, context.Canceled) || ctx.Err() != nil {
+		return
+	}
+	c.markUnhealthy(err)
+}
+
+// markUnhealthy starts bypassing the cache, logging the first failure
+func (c *conn) markUnhealthy(err error) {
+	if !c.unhealthy.Swap(true) {
+		log.Printf("Warning: Redis unavailable, bypassing cache: %v", err)
+	}
+}
+
+// checkHealth pings Redis and updates the health state
+func (c *conn) checkHealth() {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	// The check's own timeout expiring means Redis did not answer in time
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		c.markUnhealthy(err)
+		return
+	}
+	if c.unhealthy.Swap(false) {
+		log.Println("Redis reachable again, resuming cache")
+	}
+}
+
+// monitor runs health checks until the connection is closed
+func (c *conn) monitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.checkHealth()
+		}
+	}
+}
+
+// close stops the health checks and closes the client
+func (c *conn) close() error {
+	c.stopOnce.Do(func() { close(c.stop) })
+	return c.client.Close()
+}
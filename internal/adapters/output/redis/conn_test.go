@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCache_RedisDown(t *testing.T) {
+	// Reserve a port nothing listens on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	cache := NewCache(host, port)
+	t.Cleanup(func() { cache.Close() })
+	ctx := context.Background()
+
+	assert.False(t, cache.Available())
+
+	// The cache is bypassed instead of failing
+	result, err := cache.Get(ctx, "London")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.NoError(t, cache.Set(ctx, "London", createSampleWeather(), time.Hour))
+	assert.NoError(t, cache.Alias(ctx, "london", "London", time.Hour))
+}
+
+func TestCache_OutageAndRecovery(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	cache := &Cache{
+		conn: newConn(goredis.NewClient(&goredis.Options{
+			Addr:       mr.Addr(),
+			MaxRetries: -1,
+		}), 10*time.Millisecond),
+		codec: newCodec(),
+	}
+	t.Cleanup(func() { cache.Close() })
+	ctx := context.Background()
+
+	require.True(t, cache.Available())
+	require.NoError(t, cache.Set(ctx, "London", createSampleWeather(), time.Hour))
+
+	// Redis goes down: the first failure is reported and the cache is bypassed afterwards
+	mr.Close()
+	_, err = cache.Get(ctx, "London")
+	assert.Error(t, err)
+	assert.False(t, cache.Available())
+
+	result, err := cache.Get(ctx, "London")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.NoError(t, cache.Set(ctx, "Paris", createSampleWeather(), time.Hour))
+
+	// Redis comes back: the health check resumes caching
+	require.NoError(t, mr.Restart())
+	require.Eventually(t, cache.Available, time.Second, 10*time.Millisecond)
+
+	result, err = cache.Get(ctx, "London")
+	require.NoError(t, err)
+	assert.NotNil(t, result, "data written before the outage is still there")
+}
+
+func TestCache_CallerDeadline_KeepsRedisAvailable(t *testing.T) {
+	_, cache := setupTestRedis(t)
+
+	// The caller's deadline has passed before the command is sent
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	_, err := cache.Get(ctx, "London")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, cache.Available())
+
+	err = cache.Set(ctx, "London", createSampleWeather(), time.Hour)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, cache.Available())
+}
@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// Store is a typed Redis cache for domain data other than current weather
+// (forecasts, history, ...). It shares the connection and health state of the
+// Cache it was created from, so closing that Cache also closes every Store.
+type Store[T any] struct {
+	conn  *conn
+	codec codec
+}
+
+// NewStore creates a typed cache adapter on top of an existing Redis cache connection
+func NewStore[T any](cache *Cache) *Store[T] {
+	return &Store[T]{
+		conn:  cache.conn,
+		codec: cache.codec,
+	}
+}
+
+// Get retrieves a value from Redis cache
+// Returns nil and no error if the key doesn't exist (cache miss)
+func (s *Store[T]) Get(ctx context.Context, key string) (*T, error) {
+	return getValue[T](ctx, s.conn, key)
+}
+
+// Peek retrieves a value like Get; Redis keeps no bookkeeping reads could disturb
+func (s *Store[T]) Peek(ctx context.Context, key string) (*T, error) {
+	return s.Get(ctx, key)
+}
+
+// Set stores a value in Redis cache with the given TTL
+func (s *Store[T]) Set(ctx context.Context, key string, data *T, ttl time.Duration) error {
+	return setValue(ctx, s.conn, s.codec, key, data, ttl)
+}
+
+// Alias makes alias resolve to the value stored under key for the given TTL
+func (s *Store[T]) Alias(ctx context.Context, alias, key string, ttl time.Duration) error {
+	return setAlias(ctx, s.conn, alias, key, ttl)
+}
+
+// List lists the entries whose keys start with prefix together with the values they resolve to
+// Entries of other data types are listed too, without their values.
+func (s *Store[T]) List(ctx context.Context, prefix string) ([]weather.CachedValue[T], error) {
+	return listValues[T](ctx, s.conn, prefix)
+}
+
+// Namespace identifies the Redis database and key namespace, shared with the Cache the store was created from
+func (s *Store[T]) Namespace() string {
+	return s.conn.namespace()
+}
+
+// Keys lists the keys starting with prefix, across every data type
+func (s *Store[T]) Keys(ctx context.Context, prefix string) ([]string, error) {
+	return scanKeys(ctx, s.conn, prefix)
+}
+
+// Inspect describes the entry stored under key without following aliases
+// Returns nil and no error if the key doesn't exist
+func (s *Store[T]) Inspect(ctx context.Context, key string) (*weather.CacheEntry, error) {
+	return inspectEntry(ctx, s.conn, key)
+}
+
+// Delete removes the given keys and returns how many existed
+func (s *Store[T]) Delete(ctx context.Context, keys ...string) (int, error) {
+	return deleteKeys(ctx, s.conn, keys)
+}
@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestStore_SetAndGet(t *testing.T) {
+	mr, cache := setupTestRedis(t)
+	store := NewStore[weather.Forecast](cache)
+	ctx := context.Background()
+
+	forecast := &weather.Forecast{
+		Location: weather.Location{Name: "Athens"},
+		Days: []weather.DailyWeather{
+			{MaxTemp: weather.TemperatureValue{Celsius: 18.2}, ChanceOfRain: 80},
+		},
+	}
+
+	err := store.Set(ctx, "forecast:Athens:1", forecast, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, mr.Exists(keyPrefix+"forecast:Athens:1"))
+
+	result, err := store.Get(ctx, "forecast:Athens:1")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Athens", result.Location.Name)
+	require.Len(t, result.Days, 1)
+	assert.Equal(t, 18.2, result.Days[0].MaxTemp.Celsius)
+	assert.Equal(t, 80, result.Days[0].ChanceOfRain)
+}
+
+func TestStore_Get_NotFound(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	store := NewStore[weather.Forecast](cache)
+
+	result, err := store.Get(context.Background(), "forecast:nowhere:3")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestStore_Alias(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	store := NewStore[weather.Forecast](cache)
+	ctx := context.Background()
+
+	forecast := &weather.Forecast{Location: weather.Location{Name: "London"}}
+	require.NoError(t, store.Set(ctx, "forecast:51.52,-0.11:1", forecast, time.Hour))
+
+	err := store.Alias(ctx, "forecast:london:1", "forecast:51.52,-0.11:1", time.Hour)
+	require.NoError(t, err)
+
+	result, err := store.Get(ctx, "forecast:london:1")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "London", result.Location.Name)
+}
+
+func TestStore_Namespace_SharedWithCache(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	store := NewStore[weather.Forecast](cache)
+
+	assert.Equal(t, cache.Namespace(), store.Namespace())
+}
+
+func TestStore_List_LeavesOtherDataTypesWithoutValues(t *testing.T) {
+	_, cache := setupTestRedis(t)
+	store := NewStore[weather.Forecast](cache)
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "current:athens", createSampleWeather(), 0))
+
+	listed, err := store.List(ctx, "")
+
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "current:athens", listed[0].Key)
+	assert.Nil(t, listed[0].Value)
+}
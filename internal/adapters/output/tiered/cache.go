@@ -0,0 +1,142 @@
+package tiered
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// Tier is a cache level, such as the in-memory or the Redis cache adapter
+type Tier[T any] interface {
+	// Get returns nil and no error on a cache miss
+	Get(ctx context.Context, key string) (*T, error)
+	Peek(ctx context.Context, key string) (*T, error)
+	Set(ctx context.Context, key string, value *T, ttl time.Duration) error
+	Alias(ctx context.Context, alias, key string, ttl time.Duration) error
+	List(ctx context.Context, prefix string) ([]weather.CachedValue[T], error)
+	Namespace() string
+	Keys(ctx context.Context, prefix string) ([]string, error)
+	Inspect(ctx context.Context, key string) (*weather.CacheEntry, error)
+	Delete(ctx context.Context, keys ...string) (int, error)
+}
+
+// Stats holds hit and miss counts per tier
+type Stats struct {
+	L1Hits   int64
+	L1Misses int64
+	L2Hits   int64
+	L2Misses int64
+}
+
+// Cache composes a fast local cache (L1) with a shared cache (L2)
+// Reads go through L1 to L2, filling L1 on an L2 hit; writes go to both.
+// L1 entries live for at most l1TTL, so instances sharing L2 converge quickly.
+type Cache[T any] struct {
+	l1    Tier[T]
+	l2    Tier[T]
+	l1TTL time.Duration
+
+	l1Hits   atomic.Int64
+	l1Misses atomic.Int64
+	l2Hits   atomic.Int64
+	l2Misses atomic.Int64
+}
+
+// NewCache creates a two-level cache
+func NewCache[T any](l1, l2 Tier[T], l1TTL time.Duration) *Cache[T] {
+	return &Cache[T]{
+		l1:    l1,
+		l2:    l2,
+		l1TTL: l1TTL,
+	}
+}
+
+// Get retrieves a value from L1, or from L2 on an L1 miss
+// Returns nil and no error if neither tier has the key (cache miss)
+func (c *Cache[T]) Get(ctx context.Context, key string) (*T, error) {
+	if value, err := c.l1.Get(ctx, key); err == nil && value != nil {
+		c.l1Hits.Add(1)
+		return value, nil
+	}
+	c.l1Misses.Add(1)
+
+	value, err := c.l2.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		c.l2Misses.Add(1)
+		return nil, nil
+	}
+	c.l2Hits.Add(1)
+
+	// Fill L1; it is local, so a failure only costs a later L2 round-trip
+	_ = c.l1.Set(ctx, key, value, c.l1TTL)
+	return value, nil
+}
+
+// Peek retrieves a value from L2 without filling L1 or counting hits and misses
+// L2 holds every entry, so L1 is not needed to find it.
+func (c *Cache[T]) Peek(ctx context.Context, key string) (*T, error) {
+	return c.l2.Peek(ctx, key)
+}
+
+// Set stores a value in both tiers, keeping it in L1 for at most the L1 TTL
+func (c *Cache[T]) Set(ctx context.Context, key string, value *T, ttl time.Duration) error {
+	_ = c.l1.Set(ctx, key, value, c.boundL1(ttl))
+	return c.l2.Set(ctx, key, value, ttl)
+}
+
+// Alias makes alias resolve to the value stored under key in both tiers
+func (c *Cache[T]) Alias(ctx context.Context, alias, key string, ttl time.Duration) error {
+	_ = c.l1.Alias(ctx, alias, key, c.boundL1(ttl))
+	return c.l2.Alias(ctx, alias, key, ttl)
+}
+
+// List lists the L2 entries whose keys start with prefix together with the values they resolve to
+// L2 holds every entry, and its TTLs are the authoritative ones.
+func (c *Cache[T]) List(ctx context.Context, prefix string) ([]weather.CachedValue[T], error) {
+	return c.l2.List(ctx, prefix)
+}
+
+// Namespace identifies the L2 store, which keys are listed from
+func (c *Cache[T]) Namespace() string {
+	return c.l2.Namespace()
+}
+
+// Keys lists the L2 keys starting with prefix
+func (c *Cache[T]) Keys(ctx context.Context, prefix string) ([]string, error) {
+	return c.l2.Keys(ctx, prefix)
+}
+
+// Inspect describes the L2 entry stored under key without following aliases
+func (c *Cache[T]) Inspect(ctx context.Context, key string) (*weather.CacheEntry, error) {
+	return c.l2.Inspect(ctx, key)
+}
+
+// Delete removes the given keys from both tiers and returns how many existed in L2
+// Other instances sharing L2 keep their L1 copies for at most the L1 TTL.
+func (c *Cache[T]) Delete(ctx context.Context, keys ...string) (int, error) {
+	_, _ = c.l1.Delete(ctx, keys...)
+	return c.l2.Delete(ctx, keys...)
+}
+
+// Stats returns the hit and miss counts of each tier
+func (c *Cache[T]) Stats() Stats {
+	return Stats{
+		L1Hits:   c.l1Hits.Load(),
+		L1Misses: c.l1Misses.Load(),
+		L2Hits:   c.l2Hits.Load(),
+		L2Misses: c.l2Misses.Load(),
+	}
+}
+
+// boundL1 caps a TTL at the L1 TTL; a zero TTL (no expiry) is capped too
+func (c *Cache[T]) boundL1(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return c.l1TTL
+	}
+	return min(ttl, c.l1TTL)
+}
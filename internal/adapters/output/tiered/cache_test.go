@@ -0,0 +1,297 @@
+package tiered
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/adapters/output/memory"
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockTier struct {
+	mock.Mock
+}
+
+func (m *MockTier) Get(ctx context.Context, key string) (*weather.Weather, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Weather), args.Error(1)
+}
+
+func (m *MockTier) Peek(ctx context.Context, key string) (*weather.Weather, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Weather), args.Error(1)
+}
+
+func (m *MockTier) Set(ctx context.Context, key string, value *weather.Weather, ttl time.Duration) error {
+	args := m.Called(ctx, key, value, ttl)
+	return args.Error(0)
+}
+
+func (m *MockTier) Alias(ctx context.Context, alias, key string, ttl time.Duration) error {
+	args := m.Called(ctx, alias, key, ttl)
+	return args.Error(0)
+}
+
+func (m *MockTier) List(ctx context.Context, prefix string) ([]weather.CachedWeather, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]weather.CachedWeather), args.Error(1)
+}
+
+func (m *MockTier) Namespace() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockTier) Keys(ctx context.Context, prefix string) ([]string, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTier) Inspect(ctx context.Context, key string) (*weather.CacheEntry, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.CacheEntry), args.Error(1)
+}
+
+func (m *MockTier) Delete(ctx context.Context, keys ...string) (int, error) {
+	args := m.Called(ctx, keys)
+	return args.Int(0), args.Error(1)
+}
+
+func sampleWeather(name string) *weather.Weather {
+	return &weather.Weather{Location: weather.Location{Name: name}}
+}
+
+func TestCache_L1Hit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l1 := memory.NewCache[weather.Weather](10)
+	l2 := new(MockTier)
+	require.NoError(t, l1.Set(ctx, "current:london", sampleWeather("London"), time.Minute))
+
+	cache := NewCache[weather.Weather](l1, l2, time.Minute)
+
+	// Act
+	result, err := cache.Get(ctx, "current:london")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "London", result.Location.Name)
+	assert.Equal(t, Stats{L1Hits: 1}, cache.Stats())
+	l2.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestCache_L2Hit_FillsL1(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l1 := memory.NewCache[weather.Weather](10)
+	l2 := new(MockTier)
+	l2.On("Get", ctx, "current:london").Return(sampleWeather("London"), nil).Once()
+
+	cache := NewCache[weather.Weather](l1, l2, time.Minute)
+
+	// Act
+	first, err := cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+	second, err := cache.Get(ctx, "current:london")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "London", first.Location.Name)
+	assert.Equal(t, "London", second.Location.Name)
+	assert.Equal(t, Stats{L1Hits: 1, L1Misses: 1, L2Hits: 1}, cache.Stats())
+	l2.AssertExpectations(t)
+}
+
+func TestCache_Peek_SkipsL1AndStats(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l1 := memory.NewCache[weather.Weather](10)
+	l2 := new(MockTier)
+	l2.On("Peek", ctx, "current:london").Return(sampleWeather("London"), nil)
+
+	cache := NewCache[weather.Weather](l1, l2, time.Minute)
+
+	// Act
+	result, err := cache.Peek(ctx, "current:london")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "London", result.Location.Name)
+	assert.Equal(t, Stats{}, cache.Stats())
+	cached, _ := l1.Get(ctx, "current:london")
+	assert.Nil(t, cached, "L1 is not filled")
+	l2.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestCache_Miss(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l2 := new(MockTier)
+	l2.On("Get", ctx, "current:atlantis").Return(nil, nil)
+
+	cache := NewCache[weather.Weather](memory.NewCache[weather.Weather](10), l2, time.Minute)
+
+	// Act
+	result, err := cache.Get(ctx, "current:atlantis")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, Stats{L1Misses: 1, L2Misses: 1}, cache.Stats())
+}
+
+func TestCache_L2Error(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l2 := new(MockTier)
+	l2.On("Get", ctx, "current:london").Return(nil, errors.New("connection refused"))
+
+	cache := NewCache[weather.Weather](memory.NewCache[weather.Weather](10), l2, time.Minute)
+
+	// Act
+	result, err := cache.Get(ctx, "current:london")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCache_Set_WritesThroughBothTiers(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l1 := memory.NewCache[weather.Weather](10)
+	l2 := new(MockTier)
+	data := sampleWeather("London")
+	l2.On("Set", ctx, "current:london", data, 24*time.Hour).Return(nil)
+
+	cache := NewCache[weather.Weather](l1, l2, time.Minute)
+
+	// Act
+	err := cache.Set(ctx, "current:london", data, 24*time.Hour)
+
+	// Assert
+	require.NoError(t, err)
+	cached, _ := l1.Get(ctx, "current:london")
+	assert.Same(t, data, cached)
+	l2.AssertExpectations(t)
+}
+
+func TestCache_Set_ReturnsL2Error(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l2 := new(MockTier)
+	l2.On("Set", ctx, "current:london", mock.Anything, time.Hour).Return(errors.New("connection refused"))
+
+	cache := NewCache[weather.Weather](memory.NewCache[weather.Weather](10), l2, time.Minute)
+
+	// Act
+	err := cache.Set(ctx, "current:london", sampleWeather("London"), time.Hour)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestCache_Alias_BothTiers(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l1 := memory.NewCache[weather.Weather](10)
+	l2 := new(MockTier)
+	l2.On("Set", ctx, "current:51.52,-0.11", mock.Anything, time.Hour).Return(nil)
+	l2.On("Alias", ctx, "current:london", "current:51.52,-0.11", 24*time.Hour).Return(nil)
+
+	cache := NewCache[weather.Weather](l1, l2, time.Minute)
+	require.NoError(t, cache.Set(ctx, "current:51.52,-0.11", sampleWeather("London"), time.Hour))
+
+	// Act
+	err := cache.Alias(ctx, "current:london", "current:51.52,-0.11", 24*time.Hour)
+	require.NoError(t, err)
+	result, err := cache.Get(ctx, "current:london")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "London", result.Location.Name)
+	l2.AssertExpectations(t)
+	l2.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestCache_Delete_BothTiers(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l1 := memory.NewCache[weather.Weather](10)
+	l2 := new(MockTier)
+	l2.On("Set", ctx, "current:london", mock.Anything, time.Hour).Return(nil)
+	l2.On("Delete", ctx, []string{"current:london"}).Return(1, nil)
+	l2.On("Get", ctx, "current:london").Return(nil, nil)
+
+	cache := NewCache[weather.Weather](l1, l2, time.Minute)
+	require.NoError(t, cache.Set(ctx, "current:london", sampleWeather("London"), time.Hour))
+
+	// Act
+	deleted, err := cache.Delete(ctx, "current:london")
+	require.NoError(t, err)
+	result, err := cache.Get(ctx, "current:london")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.Nil(t, result, "the L1 copy is gone too")
+	l2.AssertExpectations(t)
+}
+
+func TestCache_List_UsesL2(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l2 := new(MockTier)
+	listed := []weather.CachedWeather{
+		{CacheEntry: weather.CacheEntry{Key: "current:london", TTL: time.Hour}, Value: sampleWeather("London")},
+	}
+	l2.On("List", ctx, "current:").Return(listed, nil)
+
+	cache := NewCache[weather.Weather](memory.NewCache[weather.Weather](10), l2, time.Minute)
+
+	// Act
+	result, err := cache.List(ctx, "current:")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listed, result)
+}
+
+func TestCache_KeysAndNamespace_UseL2(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	l2 := new(MockTier)
+	l2.On("Namespace").Return("redis://localhost:6379/0/weather:v1:")
+	l2.On("Keys", ctx, "current:").Return([]string{"current:london"}, nil)
+
+	cache := NewCache[weather.Weather](memory.NewCache[weather.Weather](10), l2, time.Minute)
+
+	// Act
+	keys, err := cache.Keys(ctx, "current:")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"current:london"}, keys)
+	assert.Equal(t, "redis://localhost:6379/0/weather:v1:", cache.Namespace())
+}
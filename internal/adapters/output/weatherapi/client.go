@@ -8,7 +8,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"weather-api-wrapper/internal/adapters/redact"
 	"weather-api-wrapper/internal/domain/weather"
 )
 
@@ -20,33 +24,238 @@ var (
 	ErrSerializationData      = errors.New("failed to serialize weather data")
 )
 
+// DefaultCallTimeout bounds a call to WeatherAPI.com, retries included, unless another is configured
+const DefaultCallTimeout = 15 * time.Second
+
+// ProviderName identifies WeatherAPI.com as the source of data, e.g. in cache entries
+const ProviderName = "weatherapi.com"
+
+// WeatherAPI.com endpoints, relative to the configured base URL
+// Current conditions and alerts are read from the forecast endpoint, the only
+// one that supports the alerts=yes option; the day of hourly forecast it also
+// returns is discarded
+const (
+	forecastEndpoint  = "forecast.json"
+	historyEndpoint   = "history.json"
+	astronomyEndpoint = "astronomy.json"
+	searchEndpoint    = "search.json"
+)
+
 // Client implements the WeatherProvider port for WeatherAPI.com
 type Client struct {
 	apiKey  string
 	baseURL string
 	client  *http.Client
+	retry   RetryPolicy
+	// callTimeout bounds each call, retries included, whatever the caller's deadline
+	callTimeout time.Duration
+	// redactor keeps the API key out of returned errors
+	redactor *redact.Redactor
+}
+
+// Option configures a WeatherAPI client
+type Option func(*Client)
+
+// WithRetryPolicy sets how failed requests are retried
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithHTTPClient sets the HTTP client requests are made with, see NewHTTPClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithCallTimeout bounds each call, retries included, independently of the caller's deadline
+// A zero timeout leaves calls bounded by the caller's deadline only.
+func WithCallTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.callTimeout = timeout
+	}
 }
 
 // NewClient creates a new WeatherAPI client adapter
-func NewClient(apiKey string, baseURL string) *Client {
-	return &Client{
-		apiKey:  apiKey,
-		baseURL: baseURL,
-		client:  http.DefaultClient,
+// baseURL is the API root (e.g. https://api.weatherapi.com/v1) that endpoints are appended to
+// Unless configured otherwise, it uses an HTTP client built from DefaultHTTPSettings.
+func NewClient(apiKey string, baseURL string, opts ...Option) *Client {
+	// The default settings have no proxy URL to reject
+	httpClient, _ := NewHTTPClient(DefaultHTTPSettings())
+
+	c := &Client{
+		apiKey:      apiKey,
+		baseURL:     baseURL,
+		client:      httpClient,
+		retry:       DefaultRetryPolicy(),
+		callTimeout: DefaultCallTimeout,
+		redactor:    redact.New(apiKey),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // FetchWeather implements the WeatherProvider port
-// It fetches weather data from the external WeatherAPI.com service
-// and converts the response to domain models
-func (c *Client) FetchWeather(ctx context.Context, location string) (*weather.Weather, error) {
+// It fetches weather data, including air quality and active alerts, from the
+// external WeatherAPI.com service and converts the response to domain models
+func (c *Client) FetchWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error) {
+	params := url.Values{}
+	params.Set("q", locationParam(query))
+	params.Set("days", "1")
+	params.Set("aqi", "yes")
+	params.Set("alerts", "yes")
+
+	var apiResponse APIWeatherResponse
+	if err := c.get(ctx, forecastEndpoint, params, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	// Map API model to domain model
+	domainWeather := MapAPIResponseToDomain(&apiResponse)
+
+	return domainWeather, nil
+}
+
+// FetchForecast implements the ForecastProvider port
+// It fetches a multi-day forecast including hourly entries and astronomy data
+func (c *Client) FetchForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error) {
+	params := url.Values{}
+	params.Set("q", locationParam(query))
+	params.Set("days", strconv.Itoa(days))
+	params.Set("aqi", "no")
+	params.Set("alerts", "no")
+
+	var apiResponse APIForecastResponse
+	if err := c.get(ctx, forecastEndpoint, params, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return MapForecastResponseToDomain(&apiResponse), nil
+}
+
+// FetchAlerts implements the AlertProvider port
+// It fetches the government weather alerts issued for a location
+func (c *Client) FetchAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error) {
+	params := url.Values{}
+	params.Set("q", locationParam(query))
+	params.Set("days", "1")
+	params.Set("aqi", "no")
+	params.Set("alerts", "yes")
+
+	var apiResponse APIWeatherResponse
+	if err := c.get(ctx, forecastEndpoint, params, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return MapAlertsResponseToDomain(&apiResponse), nil
+}
+
+// FetchHistory implements the HistoryProvider port
+// It fetches observed weather for each day between from and to, inclusive
+func (c *Client) FetchHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error) {
+	params := url.Values{}
+	params.Set("q", locationParam(query))
+	params.Set("dt", from.Format(weather.DateLayout))
+	if to.After(from) {
+		params.Set("end_dt", to.Format(weather.DateLayout))
+	}
+
+	var apiResponse APIForecastResponse
+	if err := c.get(ctx, historyEndpoint, params, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return MapHistoryResponseToDomain(&apiResponse), nil
+}
+
+// FetchAstronomy implements the AstronomyProvider port
+// It fetches sunrise, sunset, moonrise, moonset and moon phase for a date
+func (c *Client) FetchAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error) {
+	params := url.Values{}
+	params.Set("q", locationParam(query))
+	params.Set("dt", date.Format(weather.DateLayout))
+
+	var apiResponse APIAstronomyResponse
+	if err := c.get(ctx, astronomyEndpoint, params, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return MapAstronomyResponseToDomain(&apiResponse, date), nil
+}
+
+// SearchLocations implements the LocationSearchProvider port
+// It fetches the locations whose names match a partial query, for autocomplete
+func (c *Client) SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error) {
+	params := url.Values{}
+	params.Set("q", query)
+
+	var apiResults []APISearchResult
+	if err := c.get(ctx, searchEndpoint, params, &apiResults); err != nil {
+		return nil, err
+	}
+
+	return MapSearchResponseToDomain(query, apiResults), nil
+}
+
+// locationParam formats a location query as WeatherAPI.com's q parameter
+func locationParam(query weather.LocationQuery) string {
+	switch query.Kind {
+	case weather.LocationByCoordinates:
+		return strconv.FormatFloat(query.Latitude, 'f', -1, 64) + "," + strconv.FormatFloat(query.Longitude, 'f', -1, 64)
+	case weather.LocationByIATA:
+		return "iata:" + query.Name
+	case weather.LocationByIP:
+		return query.IP
+	default:
+		// Names and postcodes are passed as is
+		return query.Name
+	}
+}
+
+// get performs a GET request against a WeatherAPI.com endpoint
+// and unmarshals a successful response into dest
+// Transient failures are retried according to the client's retry policy, within the call timeout.
+// The API key travels in the query string; it is scrubbed from every returned error.
+func (c *Client) get(ctx context.Context, endpoint string, params url.Values, dest any) error {
+	if c.callTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.callTimeout)
+		defer cancel()
+	}
+
 	// Build the API request URL
-	reqURL := fmt.Sprintf("%s?key=%s&q=%s&aqi=no", c.baseURL, c.apiKey, url.QueryEscape(location))
+	params.Set("key", c.apiKey)
+	reqURL := fmt.Sprintf("%s/%s?%s", strings.TrimRight(c.baseURL, "/"), endpoint, params.Encode())
 
+	var body []byte
+	err := c.retry.retry(ctx, func() error {
+		var err error
+		body, err = c.attempt(ctx, reqURL)
+		return err
+	})
+	if err != nil {
+		return c.redactor.Error(err)
+	}
+
+	// Unmarshal into API-specific model
+	if err := json.Unmarshal(body, dest); err != nil {
+		return fmt.Errorf("%w: %v", ErrSerializationData, err)
+	}
+
+	return nil
+}
+
+// attempt performs a single GET request and returns the body of a successful response
+// Failures worth retrying are returned as a *retryableError.
+func (c *Client) attempt(ctx context.Context, reqURL string) ([]byte, error) {
 	// Create HTTP request with context
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrFailedToFetchWeather, err)
+		return nil, fmt.Errorf("%w: %v", ErrFailedToFetchWeather, c.scrubURL(err))
 	}
 
 	// Execute the request
@@ -54,31 +263,51 @@ func (c *Client) FetchWeather(ctx context.Context, location string) (*weather.We
 	if err != nil {
 		// Check if the error is due to context cancellation
 		if ctx.Err() != nil {
-			return nil, fmt.Errorf("%w: %v", ErrFailedToFetchWeather, ctx.Err())
+			return nil, fmt.Errorf("%w: %w", ErrFailedToFetchWeather, ctx.Err())
 		}
-		return nil, fmt.Errorf("%w: %v", ErrFailedToFetchWeather, err)
+		// Network errors, such as a reset connection
+		return nil, &retryableError{err: fmt.Errorf("%w: %v", ErrFailedToFetchWeather, c.scrubURL(err))}
 	}
 	defer resp.Body.Close()
 
 	// Read the response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrParseWeatherData, err)
+		err = fmt.Errorf("%w: %v", ErrParseWeatherData, err)
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return nil, &retryableError{err: err}
 	}
 
 	// Check for non-OK status
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: status %d, response: %s", ErrAPIReturnedNonOKStatus, resp.StatusCode, string(body))
+		err := statusError(resp.StatusCode, body)
+		if retryableStatus(resp.StatusCode) {
+			return nil, &retryableError{err: err, retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+		}
+		return nil, err
 	}
 
-	// Unmarshal into API-specific model
-	var apiResponse APIWeatherResponse
-	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrSerializationData, err)
-	}
+	return body, nil
+}
 
-	// Map API model to domain model
-	domainWeather := MapAPIResponseToDomain(&apiResponse)
+// scrubURL removes the API key from the request URL an HTTP client error carries
+// The *url.Error is modified in place, since it belongs to the failed request.
+func (c *Client) scrubURL(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
 
-	return domainWeather, nil
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		urlErr.URL = c.redactor.String(urlErr.URL)
+		return err
+	}
+	query := u.Query()
+	query.Del("key")
+	u.RawQuery = query.Encode()
+	urlErr.URL = u.String()
+	return err
 }
@@ -5,9 +5,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
 )
 
 func TestNewClient(t *testing.T) {
@@ -80,7 +83,17 @@ func TestClient_FetchWeather(t *testing.T) {
 					"short_rad": 0.0,
 					"diff_rad": 0.0,
 					"dni": 0.0,
-					"gti": 0.0
+					"gti": 0.0,
+					"air_quality": {
+						"co": 223.6,
+						"no2": 29.4,
+						"o3": 41.5,
+						"so2": 5.2,
+						"pm2_5": 12.8,
+						"pm10": 17.3,
+						"us-epa-index": 1,
+						"gb-defra-index": 2
+					}
 				}
 			}`,
 			location:          "London",
@@ -121,7 +134,7 @@ func TestClient_FetchWeather(t *testing.T) {
 
 				// Verify query parameters
 				assert.Equal(t, tt.location, r.URL.Query().Get("q"))
-				assert.Equal(t, "no", r.URL.Query().Get("aqi"))
+				assert.Equal(t, "yes", r.URL.Query().Get("aqi"))
 			}))
 			defer server.Close()
 
@@ -130,7 +143,7 @@ func TestClient_FetchWeather(t *testing.T) {
 
 			// Execute
 			ctx := context.Background()
-			result, err := client.FetchWeather(ctx, tt.location)
+			result, err := client.FetchWeather(ctx, nameQuery(tt.location))
 
 			// Assert
 			if tt.expectError {
@@ -147,6 +160,11 @@ func TestClient_FetchWeather(t *testing.T) {
 				assert.Equal(t, 13.0, result.Current.Wind.SpeedKph)
 				assert.Equal(t, 82, result.Current.Humidity)
 				assert.False(t, result.Current.IsDay) // is_day: 0 -> false
+				require.NotNil(t, result.Current.AirQuality)
+				assert.Equal(t, 29.4, result.Current.AirQuality.NitrogenDioxide)
+				assert.Equal(t, 12.8, result.Current.AirQuality.PM2_5)
+				assert.Equal(t, 1, result.Current.AirQuality.USEPAIndex)
+				assert.Equal(t, 2, result.Current.AirQuality.UKDEFRAIndex)
 			}
 		})
 	}
@@ -166,7 +184,7 @@ func TestClient_FetchWeather_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	result, err := client.FetchWeather(ctx, "London")
+	result, err := client.FetchWeather(ctx, nameQuery("London"))
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -190,9 +208,371 @@ func TestClient_FetchWeather_URLEncoding(t *testing.T) {
 	client := NewClient("test-key", server.URL)
 
 	ctx := context.Background()
-	result, err := client.FetchWeather(ctx, "New York")
+	result, err := client.FetchWeather(ctx, nameQuery("New York"))
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, "New York", result.Location.Name)
+	// No air_quality block in the response
+	assert.Nil(t, result.Current.AirQuality)
+}
+
+func TestClient_FetchWeather_Endpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/forecast.json", r.URL.Path)
+		assert.Equal(t, "test-key", r.URL.Query().Get("key"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"location": {"name": "London"}, "current": {"temp_c": 15.0}}`))
+	}))
+	defer server.Close()
+
+	// A trailing slash on the base URL must not produce a double slash
+	client := NewClient("test-key", server.URL+"/v1/")
+
+	result, err := client.FetchWeather(context.Background(), nameQuery("London"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "London", result.Location.Name)
+}
+
+func TestClient_FetchForecast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/forecast.json", r.URL.Path)
+		assert.Equal(t, "Athens", r.URL.Query().Get("q"))
+		assert.Equal(t, "2", r.URL.Query().Get("days"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"location": {"name": "Athens", "country": "Greece", "tz_id": "Europe/Athens"},
+			"current": {"temp_c": 14.0},
+			"forecast": {
+				"forecastday": [
+					{
+						"date": "2026-03-02",
+						"date_epoch": 1772409600,
+						"day": {
+							"maxtemp_c": 18.2, "mintemp_c": 9.1, "avgtemp_c": 13.4,
+							"maxwind_kph": 20.5, "totalprecip_mm": 1.2, "avghumidity": 71,
+							"daily_will_it_rain": 1, "daily_chance_of_rain": 86,
+							"condition": {"text": "Patchy rain nearby", "code": 1063},
+							"uv": 3.0
+						},
+						"astro": {
+							"sunrise": "06:52 AM", "sunset": "06:26 PM",
+							"moonrise": "No moonrise", "moonset": "08:14 AM",
+							"moon_phase": "Waning Gibbous", "moon_illumination": "85"
+						},
+						"hour": [
+							{"time_epoch": 1772402400, "time": "2026-03-02 00:00", "temp_c": 10.5, "chance_of_rain": 12, "is_day": 0}
+						]
+					},
+					{
+						"date": "2026-03-03",
+						"day": {"maxtemp_c": 19.0, "mintemp_c": 10.0},
+						"astro": {"sunrise": "06:51 AM", "sunset": "06:27 PM", "moon_illumination": 78},
+						"hour": []
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.FetchForecast(context.Background(), nameQuery("Athens"), 2)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Athens", result.Location.Name)
+	require.Len(t, result.Days, 2)
+
+	day := result.Days[0]
+	assert.Equal(t, 18.2, day.MaxTemp.Celsius)
+	assert.Equal(t, 9.1, day.MinTemp.Celsius)
+	assert.Equal(t, 86, day.ChanceOfRain)
+	assert.True(t, day.WillItRain)
+	assert.Equal(t, 71, day.AvgHumidity)
+	assert.Equal(t, "Patchy rain nearby", day.Condition.Text)
+	assert.Equal(t, 6, day.Astronomy.Sunrise.Hour())
+	assert.Equal(t, 52, day.Astronomy.Sunrise.Minute())
+	assert.Equal(t, 18, day.Astronomy.Sunset.Hour())
+	assert.True(t, day.Astronomy.Moonrise.IsZero())
+	assert.Equal(t, 85, day.Astronomy.MoonIllumination)
+	require.Len(t, day.Hours, 1)
+	assert.Equal(t, 10.5, day.Hours[0].Temperature.Celsius)
+	assert.False(t, day.Hours[0].IsDay)
+
+	assert.Equal(t, 78, result.Days[1].Astronomy.MoonIllumination)
+}
+
+func TestClient_FetchForecast_NonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("internal server error"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.FetchForecast(context.Background(), nameQuery("Athens"), 3)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+}
+
+func TestClient_FetchHistory(t *testing.T) {
+	tests := []struct {
+		name          string
+		from          time.Time
+		to            time.Time
+		expectedEndDt string
+	}{
+		{
+			name: "Single date",
+			from: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Date range",
+			from:          time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			to:            time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+			expectedEndDt: "2026-03-04",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/history.json", r.URL.Path)
+				assert.Equal(t, "Athens", r.URL.Query().Get("q"))
+				assert.Equal(t, "2026-03-02", r.URL.Query().Get("dt"))
+				assert.Equal(t, tt.expectedEndDt, r.URL.Query().Get("end_dt"))
+
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{
+					"location": {"name": "Athens", "tz_id": "Europe/Athens"},
+					"forecast": {
+						"forecastday": [
+							{
+								"date": "2026-03-02",
+								"day": {"maxtemp_c": 16.4, "mintemp_c": 7.9, "totalprecip_mm": 4.2},
+								"astro": {"sunrise": "06:52 AM", "sunset": "06:26 PM"}
+							}
+						]
+					}
+				}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key", server.URL)
+
+			result, err := client.FetchHistory(context.Background(), nameQuery("Athens"), tt.from, tt.to)
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, "Athens", result.Location.Name)
+			require.Len(t, result.Days, 1)
+			assert.Equal(t, 16.4, result.Days[0].MaxTemp.Celsius)
+			assert.Equal(t, 4.2, result.Days[0].TotalPrecip.Millimeters)
+		})
+	}
+}
+
+func TestClient_FetchAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/forecast.json", r.URL.Path)
+		assert.Equal(t, "Miami", r.URL.Query().Get("q"))
+		assert.Equal(t, "yes", r.URL.Query().Get("alerts"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"location": {"name": "Miami", "country": "USA"},
+			"current": {"temp_c": 27.0},
+			"alerts": {
+				"alert": [
+					{
+						"headline": "Hurricane Warning issued March 2 at 5:00AM EST",
+						"msgtype": "Alert",
+						"severity": "Extreme",
+						"urgency": "Immediate",
+						"areas": "Miami-Dade; Broward;",
+						"category": "Met",
+						"certainty": "Likely",
+						"event": "Hurricane Warning",
+						"effective": "2026-03-02T05:00:00-05:00",
+						"expires": "2026-03-03T05:00:00-05:00",
+						"desc": "Hurricane conditions expected.",
+						"instruction": "Evacuate if ordered."
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.FetchAlerts(context.Background(), nameQuery("Miami"))
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Miami", result.Location.Name)
+	require.Len(t, result.Alerts, 1)
+
+	alert := result.Alerts[0]
+	assert.Equal(t, "Hurricane Warning", alert.Event)
+	assert.Equal(t, "Extreme", alert.Severity)
+	assert.Equal(t, []string{"Miami-Dade", "Broward"}, alert.Areas)
+	assert.True(t, alert.Effective.Equal(time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)))
+	assert.True(t, alert.Expires.Equal(time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)))
+	assert.True(t, alert.IsSevere())
+}
+
+func TestClient_FetchWeather_IncludesAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "yes", r.URL.Query().Get("alerts"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"location": {"name": "Miami"},
+			"current": {"temp_c": 27.0, "vis_km": 10.0},
+			"alerts": {"alert": [{"event": "Hurricane Warning", "severity": "Extreme"}]}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.FetchWeather(context.Background(), nameQuery("Miami"))
+
+	require.NoError(t, err)
+	require.Len(t, result.Alerts, 1)
+	// An active Extreme alert makes otherwise mild conditions extreme
+	assert.True(t, result.IsExtreme())
+}
+
+func TestClient_FetchAstronomy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/astronomy.json", r.URL.Path)
+		assert.Equal(t, "London", r.URL.Query().Get("q"))
+		assert.Equal(t, "2026-06-21", r.URL.Query().Get("dt"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"location": {"name": "London", "lat": 51.52, "lon": -0.11, "tz_id": "UTC"},
+			"astronomy": {
+				"astro": {
+					"sunrise": "03:43 AM",
+					"sunset": "08:21 PM",
+					"moonrise": "12:30 PM",
+					"moonset": "No moonset",
+					"moon_phase": "First Quarter",
+					"moon_illumination": 48
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.FetchAstronomy(context.Background(), nameQuery("London"), time.Date(2026, 6, 21, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "London", result.Location.Name)
+	assert.Equal(t, "2026-06-21", result.Date.Format("2006-01-02"))
+	assert.Equal(t, time.Date(2026, 6, 21, 3, 43, 0, 0, time.UTC), result.Astronomy.Sunrise.UTC())
+	assert.Equal(t, time.Date(2026, 6, 21, 20, 21, 0, 0, time.UTC), result.Astronomy.Sunset.UTC())
+	assert.Equal(t, time.Date(2026, 6, 21, 12, 30, 0, 0, time.UTC), result.Astronomy.Moonrise.UTC())
+	assert.True(t, result.Astronomy.Moonset.IsZero())
+	assert.Equal(t, "First Quarter", result.Astronomy.MoonPhase)
+	assert.Equal(t, 48, result.Astronomy.MoonIllumination)
+	assert.False(t, result.Estimated)
+}
+
+// nameQuery builds a location query for a place name
+func nameQuery(name string) weather.LocationQuery {
+	return weather.LocationQuery{Kind: weather.LocationByName, Name: name}
+}
+
+func TestClient_FetchWeather_LocationQueryFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     weather.LocationQuery
+		expectedQ string
+	}{
+		{name: "Name", query: nameQuery("London"), expectedQ: "London"},
+		{name: "Coordinates", query: weather.LocationQuery{Kind: weather.LocationByCoordinates, Latitude: 48.8567, Longitude: 2.3508}, expectedQ: "48.8567,2.3508"},
+		{name: "Postcode", query: weather.LocationQuery{Kind: weather.LocationByPostcode, Name: "SW1A 1AA"}, expectedQ: "SW1A 1AA"},
+		{name: "IATA code", query: weather.LocationQuery{Kind: weather.LocationByIATA, Name: "LHR"}, expectedQ: "iata:LHR"},
+		{name: "IP address", query: weather.LocationQuery{Kind: weather.LocationByIP, IP: "203.0.113.7"}, expectedQ: "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var receivedQ string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				receivedQ = r.URL.Query().Get("q")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"location": {"name": "Somewhere"}, "current": {"temp_c": 10}}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key", server.URL)
+
+			_, err := client.FetchWeather(context.Background(), tt.query)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedQ, receivedQ)
+		})
+	}
+}
+
+func TestClient_SearchLocations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search.json", r.URL.Path)
+		assert.Equal(t, "Springfield", r.URL.Query().Get("q"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[
+			{"id": 2640463, "name": "Springfield", "region": "Illinois", "country": "United States of America", "lat": 39.8, "lon": -89.64, "url": "springfield-illinois-united-states-of-america"},
+			{"id": 2640489, "name": "Springfield", "region": "Missouri", "country": "United States of America", "lat": 37.22, "lon": -93.3, "url": "springfield-missouri-united-states-of-america"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.SearchLocations(context.Background(), "Springfield")
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Springfield", result.Query)
+	require.Len(t, result.Candidates, 2)
+	assert.True(t, result.IsAmbiguous())
+
+	candidate := result.Candidates[1]
+	assert.Equal(t, 2640489, candidate.ID)
+	assert.Equal(t, "Missouri", candidate.Region)
+	assert.Equal(t, 37.22, candidate.Latitude)
+	assert.Equal(t, -93.3, candidate.Longitude)
+}
+
+func TestClient_SearchLocations_NoMatches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key", server.URL)
+
+	result, err := client.SearchLocations(context.Background(), "Xyzzy")
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Candidates)
+	assert.NotNil(t, result.Candidates)
 }
@@ -0,0 +1,46 @@
+package weatherapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// WeatherAPI.com error codes, see https://www.weatherapi.com/docs/#intro-error-codes
+const (
+	codeKeyNotProvided     = 1002
+	codeQueryNotProvided   = 1003
+	codeInvalidRequestURL  = 1005
+	codeLocationNotFound   = 1006
+	codeKeyInvalid         = 2006
+	codeQuotaExceeded      = 2007
+	codeKeyDisabled        = 2008
+	codeResourceNotAllowed = 2009
+)
+
+// domainErrors maps the WeatherAPI.com error codes the application handles to domain errors
+var domainErrors = map[int]error{
+	codeLocationNotFound:   weather.ErrWeatherNotFound,
+	codeQueryNotProvided:   weather.ErrInvalidLocation,
+	codeQuotaExceeded:      weather.ErrQuotaExceeded,
+	codeKeyNotProvided:     weather.ErrProviderMisconfigured,
+	codeInvalidRequestURL:  weather.ErrProviderMisconfigured,
+	codeKeyInvalid:         weather.ErrProviderMisconfigured,
+	codeKeyDisabled:        weather.ErrProviderMisconfigured,
+	codeResourceNotAllowed: weather.ErrProviderMisconfigured,
+}
+
+// statusError builds the error for a non-OK response
+// It wraps ErrAPIReturnedNonOKStatus, and the matching domain error when the
+// body is a WeatherAPI.com error payload with a known code.
+func statusError(status int, body []byte) error {
+	var payload APIErrorResponse
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Error.Code != 0 {
+		if domainErr, ok := domainErrors[payload.Error.Code]; ok {
+			return fmt.Errorf("%w: %w: status %d, code %d: %s", ErrAPIReturnedNonOKStatus, domainErr, status, payload.Error.Code, payload.Error.Message)
+		}
+		return fmt.Errorf("%w: status %d, code %d: %s", ErrAPIReturnedNonOKStatus, status, payload.Error.Code, payload.Error.Message)
+	}
+	return fmt.Errorf("%w: status %d, response: %s", ErrAPIReturnedNonOKStatus, status, string(body))
+}
@@ -0,0 +1,111 @@
+package weatherapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestClient_FetchWeather_MapsErrorCodes(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expectedErr error
+	}{
+		{
+			name:        "Location not found",
+			status:      http.StatusBadRequest,
+			body:        `{"error": {"code": 1006, "message": "No matching location found."}}`,
+			expectedErr: weather.ErrWeatherNotFound,
+		},
+		{
+			name:        "Query not provided",
+			status:      http.StatusBadRequest,
+			body:        `{"error": {"code": 1003, "message": "Parameter q is missing."}}`,
+			expectedErr: weather.ErrInvalidLocation,
+		},
+		{
+			name:        "Invalid request URL",
+			status:      http.StatusBadRequest,
+			body:        `{"error": {"code": 1005, "message": "API request url is invalid."}}`,
+			expectedErr: weather.ErrProviderMisconfigured,
+		},
+		{
+			name:        "Quota exceeded",
+			status:      http.StatusForbidden,
+			body:        `{"error": {"code": 2007, "message": "API key has exceeded calls per month quota."}}`,
+			expectedErr: weather.ErrQuotaExceeded,
+		},
+		{
+			name:        "Key not provided",
+			status:      http.StatusUnauthorized,
+			body:        `{"error": {"code": 1002, "message": "API key is invalid or not provided."}}`,
+			expectedErr: weather.ErrProviderMisconfigured,
+		},
+		{
+			name:        "Key invalid",
+			status:      http.StatusUnauthorized,
+			body:        `{"error": {"code": 2006, "message": "API key provided is invalid."}}`,
+			expectedErr: weather.ErrProviderMisconfigured,
+		},
+		{
+			name:        "Key disabled",
+			status:      http.StatusForbidden,
+			body:        `{"error": {"code": 2008, "message": "API key has been disabled."}}`,
+			expectedErr: weather.ErrProviderMisconfigured,
+		},
+		{
+			name:        "Resource not allowed",
+			status:      http.StatusForbidden,
+			body:        `{"error": {"code": 2009, "message": "API key does not have access to the resource."}}`,
+			expectedErr: weather.ErrProviderMisconfigured,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key", server.URL)
+
+			result, err := client.FetchWeather(context.Background(), nameQuery("Atlantis"))
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+		})
+	}
+}
+
+func TestStatusError_UnknownCode(t *testing.T) {
+	err := statusError(http.StatusBadRequest, []byte(`{"error": {"code": 9999, "message": "Internal application error."}}`))
+
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.NotErrorIs(t, err, weather.ErrWeatherNotFound)
+	assert.EqualError(t, err, "API returned non-OK status: status 400, code 9999: Internal application error.")
+}
+
+func TestStatusError_InvalidRequestURL_IsNotTheCallersFault(t *testing.T) {
+	// Our own base URL or request is wrong, whatever location was asked for
+	err := statusError(http.StatusBadRequest, []byte(`{"error": {"code": 1005, "message": "API request url is invalid."}}`))
+
+	assert.ErrorIs(t, err, weather.ErrProviderMisconfigured)
+	assert.NotErrorIs(t, err, weather.ErrInvalidLocation)
+}
+
+func TestStatusError_NotAnErrorPayload(t *testing.T) {
+	err := statusError(http.StatusBadGateway, []byte("<html>Bad Gateway</html>"))
+
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.EqualError(t, err, "API returned non-OK status: status 502, response: <html>Bad Gateway</html>")
+}
@@ -0,0 +1,89 @@
+package weatherapi
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// HTTPSettings configures the HTTP client used to call WeatherAPI.com
+type HTTPSettings struct {
+	// Timeout bounds each attempt, from dialing to reading the whole body
+	Timeout time.Duration
+
+	DialTimeout           time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration
+
+	// KeepAlive is the interval between TCP keep-alive probes on open connections
+	KeepAlive time.Duration
+	// IdleConnTimeout is how long an idle pooled connection is kept
+	IdleConnTimeout time.Duration
+
+	// Connection pool sizing; 0 means no limit for each of them
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+
+	// ProxyURL is the proxy requests go through; when empty, the HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables apply
+	ProxyURL string
+}
+
+// DefaultHTTPSettings returns the HTTP settings used unless others are configured
+func DefaultHTTPSettings() HTTPSettings {
+	return HTTPSettings{
+		Timeout:               10 * time.Second,
+		DialTimeout:           5 * time.Second,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 8 * time.Second,
+		KeepAlive:             30 * time.Second,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   100,
+		MaxConnsPerHost:       100,
+	}
+}
+
+// NewHTTPClient builds an HTTP client with its own transport from settings
+// Returns an error if the proxy URL is invalid.
+func NewHTTPClient(settings HTTPSettings) (*http.Client, error) {
+	proxy := http.ProxyFromEnvironment
+	if settings.ProxyURL != "" {
+		proxyURL, err := url.Parse(settings.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", settings.ProxyURL)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	// net/http reads 0 as its default of 2 idle connections per host, not as no limit
+	maxIdleConnsPerHost := settings.MaxIdleConnsPerHost
+	if maxIdleConnsPerHost == 0 {
+		maxIdleConnsPerHost = math.MaxInt
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   settings.DialTimeout,
+		KeepAlive: settings.KeepAlive,
+	}
+
+	return &http.Client{
+		Timeout: settings.Timeout,
+		Transport: &http.Transport{
+			Proxy:                 proxy,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			TLSHandshakeTimeout:   settings.TLSHandshakeTimeout,
+			ResponseHeaderTimeout: settings.ResponseHeaderTimeout,
+			IdleConnTimeout:       settings.IdleConnTimeout,
+			MaxIdleConns:          settings.MaxIdleConns,
+			MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+			MaxConnsPerHost:       settings.MaxConnsPerHost,
+			ExpectContinueTimeout: time.Second,
+		},
+	}, nil
+}
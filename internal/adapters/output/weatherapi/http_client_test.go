@@ -0,0 +1,134 @@
+package weatherapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	settings := DefaultHTTPSettings()
+	settings.MaxConnsPerHost = 10
+
+	client, err := NewHTTPClient(settings)
+
+	require.NoError(t, err)
+	assert.NotSame(t, http.DefaultClient, client)
+	assert.Equal(t, 10*time.Second, client.Timeout)
+
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.NotSame(t, http.DefaultTransport, transport)
+	assert.Equal(t, 8*time.Second, transport.ResponseHeaderTimeout)
+	assert.Equal(t, 5*time.Second, transport.TLSHandshakeTimeout)
+	assert.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, 100, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 10, transport.MaxConnsPerHost)
+}
+
+func TestNewHTTPClient_ZeroMeansNoLimit(t *testing.T) {
+	settings := DefaultHTTPSettings()
+	settings.MaxIdleConns = 0
+	settings.MaxIdleConnsPerHost = 0
+	settings.MaxConnsPerHost = 0
+
+	client, err := NewHTTPClient(settings)
+
+	require.NoError(t, err)
+	transport := client.Transport.(*http.Transport)
+	assert.Zero(t, transport.MaxIdleConns)
+	assert.Equal(t, math.MaxInt, transport.MaxIdleConnsPerHost)
+	assert.Zero(t, transport.MaxConnsPerHost)
+}
+
+func TestNewHTTPClient_InvalidProxy(t *testing.T) {
+	settings := DefaultHTTPSettings()
+	settings.ProxyURL = "not a proxy"
+
+	client, err := NewHTTPClient(settings)
+
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestNewHTTPClient_Proxy(t *testing.T) {
+	// The proxy answers on behalf of the API host
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.Host
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer proxy.Close()
+
+	settings := DefaultHTTPSettings()
+	settings.ProxyURL = proxy.URL
+	httpClient, err := NewHTTPClient(settings)
+	require.NoError(t, err)
+
+	client := NewClient("test-key", "http://api.weather.test/v1", WithHTTPClient(httpClient))
+
+	_, err = client.SearchLocations(context.Background(), "London")
+
+	require.NoError(t, err)
+	assert.Equal(t, "api.weather.test", <-proxied)
+}
+
+func TestClient_CallTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient("test-key", server.URL, WithCallTimeout(100*time.Millisecond))
+
+	// The caller sets no deadline of its own
+	start := time.Now()
+	result, err := client.SearchLocations(context.Background(), "London")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrFailedToFetchWeather)
+	assert.ErrorContains(t, err, "deadline exceeded")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_ResponseHeaderTimeout_IsRetried(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// Hang before answering the first attempt
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	settings := DefaultHTTPSettings()
+	settings.ResponseHeaderTimeout = 50 * time.Millisecond
+	httpClient, err := NewHTTPClient(settings)
+	require.NoError(t, err)
+
+	client := NewClient("test-key", server.URL, WithHTTPClient(httpClient), WithRetryPolicy(fastRetries(2)))
+
+	_, err = client.SearchLocations(context.Background(), "London")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
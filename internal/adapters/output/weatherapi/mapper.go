@@ -1,6 +1,7 @@
 package weatherapi
 
 import (
+	"strings"
 	"time"
 
 	"weather-api-wrapper/internal/domain/weather"
@@ -10,15 +11,7 @@ import (
 // This keeps the domain layer clean from infrastructure concerns (JSON tags, API structure)
 func MapAPIResponseToDomain(apiResponse *APIWeatherResponse) *weather.Weather {
 	return &weather.Weather{
-		Location: weather.Location{
-			Name:      apiResponse.Location.Name,
-			Region:    apiResponse.Location.Region,
-			Country:   apiResponse.Location.Country,
-			Latitude:  apiResponse.Location.Lat,
-			Longitude: apiResponse.Location.Lon,
-			Timezone:  apiResponse.Location.TzID,
-			LocalTime: time.Unix(apiResponse.Location.LocaltimeEpoch, 0),
-		},
+		Location: mapLocation(&apiResponse.Location),
 		Current: weather.CurrentWeather{
 			LastUpdated: time.Unix(apiResponse.Current.LastUpdatedEpoch, 0),
 			Temperature: weather.Temperature{
@@ -41,11 +34,7 @@ func MapAPIResponseToDomain(apiResponse *APIWeatherResponse) *weather.Weather {
 					Fahrenheit: apiResponse.Current.DewpointF,
 				},
 			},
-			Condition: weather.Condition{
-				Text: apiResponse.Current.Condition.Text,
-				Code: apiResponse.Current.Condition.Code,
-				Icon: apiResponse.Current.Condition.Icon,
-			},
+			Condition: mapCondition(apiResponse.Current.Condition),
 			Wind: weather.Wind{
 				SpeedKph:  apiResponse.Current.WindKph,
 				SpeedMph:  apiResponse.Current.WindMph,
@@ -76,7 +65,307 @@ func MapAPIResponseToDomain(apiResponse *APIWeatherResponse) *weather.Weather {
 				DNI:       apiResponse.Current.DNI,
 				GTI:       apiResponse.Current.GTI,
 			},
+			AirQuality: mapAirQuality(apiResponse.Current.AirQuality),
+		},
+		Alerts:    mapAlerts(apiResponse.Alerts),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// MapForecastResponseToDomain converts the external forecast response model to domain model
+// Dates and times are interpreted in the location's own timezone
+func MapForecastResponseToDomain(apiResponse *APIForecastResponse) *weather.Forecast {
+	tz := loadTimezone(apiResponse.Location.TzID)
+
+	days := make([]weather.DailyWeather, 0, len(apiResponse.Forecast.ForecastDay))
+	for _, apiDay := range apiResponse.Forecast.ForecastDay {
+		days = append(days, mapForecastDay(apiDay, tz))
+	}
+
+	return &weather.Forecast{
+		Location:  mapLocation(&apiResponse.Location),
+		Days:      days,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// MapHistoryResponseToDomain converts the external history response model to domain model
+// WeatherAPI.com returns history in the same shape as a forecast
+func MapHistoryResponseToDomain(apiResponse *APIForecastResponse) *weather.History {
+	forecast := MapForecastResponseToDomain(apiResponse)
+
+	return &weather.History{
+		Location:  forecast.Location,
+		Days:      forecast.Days,
+		UpdatedAt: forecast.UpdatedAt,
+	}
+}
+
+// MapSearchResponseToDomain converts the external search results to domain model
+func MapSearchResponseToDomain(query string, apiResults []APISearchResult) *weather.LocationSearch {
+	candidates := make([]weather.LocationCandidate, 0, len(apiResults))
+	for _, result := range apiResults {
+		candidates = append(candidates, weather.LocationCandidate{
+			ID:        result.ID,
+			Name:      result.Name,
+			Region:    result.Region,
+			Country:   result.Country,
+			Latitude:  result.Lat,
+			Longitude: result.Lon,
+		})
+	}
+
+	return &weather.LocationSearch{
+		Query:      query,
+		Candidates: candidates,
+		UpdatedAt:  time.Now(),
+	}
+}
+
+// MapAstronomyResponseToDomain converts the external astronomy response model to domain model
+// The requested date is interpreted in the location's own timezone
+func MapAstronomyResponseToDomain(apiResponse *APIAstronomyResponse, date time.Time) *weather.AstronomyReport {
+	tz := loadTimezone(apiResponse.Location.TzID)
+	localDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, tz)
+
+	return &weather.AstronomyReport{
+		Location:  mapLocation(&apiResponse.Location),
+		Date:      localDate,
+		Astronomy: mapAstronomy(apiResponse.Astronomy.Astro, localDate, tz),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func mapForecastDay(apiDay APIForecastDay, tz *time.Location) weather.DailyWeather {
+	date, err := time.ParseInLocation("2006-01-02", apiDay.Date, tz)
+	if err != nil {
+		date = time.Unix(apiDay.DateEpoch, 0).In(tz)
+	}
+
+	hours := make([]weather.HourlyWeather, 0, len(apiDay.Hour))
+	for _, apiHour := range apiDay.Hour {
+		hours = append(hours, mapHour(apiHour, tz))
+	}
+
+	return weather.DailyWeather{
+		Date: date,
+		MaxTemp: weather.TemperatureValue{
+			Celsius:    apiDay.Day.MaxtempC,
+			Fahrenheit: apiDay.Day.MaxtempF,
+		},
+		MinTemp: weather.TemperatureValue{
+			Celsius:    apiDay.Day.MintempC,
+			Fahrenheit: apiDay.Day.MintempF,
+		},
+		AvgTemp: weather.TemperatureValue{
+			Celsius:    apiDay.Day.AvgtempC,
+			Fahrenheit: apiDay.Day.AvgtempF,
+		},
+		MaxWind: weather.Wind{
+			SpeedKph: apiDay.Day.MaxwindKph,
+			SpeedMph: apiDay.Day.MaxwindMph,
+		},
+		TotalPrecip: weather.Precipitation{
+			Millimeters: apiDay.Day.TotalprecipMm,
+			Inches:      apiDay.Day.TotalprecipIn,
+		},
+		TotalSnowCm: apiDay.Day.TotalsnowCm,
+		AvgVisibility: weather.Distance{
+			Kilometers: apiDay.Day.AvgvisKm,
+			Miles:      apiDay.Day.AvgvisMiles,
+		},
+		AvgHumidity:  int(apiDay.Day.Avghumidity),
+		ChanceOfRain: apiDay.Day.DailyChanceOfRain,
+		ChanceOfSnow: apiDay.Day.DailyChanceOfSnow,
+		WillItRain:   apiDay.Day.DailyWillItRain == 1,
+		WillItSnow:   apiDay.Day.DailyWillItSnow == 1,
+		Condition:    mapCondition(apiDay.Day.Condition),
+		UVIndex:      apiDay.Day.UV,
+		Astronomy:    mapAstronomy(apiDay.Astro, date, tz),
+		Hours:        hours,
+	}
+}
+
+func mapHour(apiHour APIHour, tz *time.Location) weather.HourlyWeather {
+	return weather.HourlyWeather{
+		Time: time.Unix(apiHour.TimeEpoch, 0).In(tz),
+		Temperature: weather.Temperature{
+			Celsius:    apiHour.TempC,
+			Fahrenheit: apiHour.TempF,
+			FeelsLike: weather.FeelsLike{
+				Celsius:    apiHour.FeelslikeC,
+				Fahrenheit: apiHour.FeelslikeF,
+			},
+			Windchill: weather.TemperatureValue{
+				Celsius:    apiHour.WindchillC,
+				Fahrenheit: apiHour.WindchillF,
+			},
+			HeatIndex: weather.TemperatureValue{
+				Celsius:    apiHour.HeatindexC,
+				Fahrenheit: apiHour.HeatindexF,
+			},
+			Dewpoint: weather.TemperatureValue{
+				Celsius:    apiHour.DewpointC,
+				Fahrenheit: apiHour.DewpointF,
+			},
+		},
+		Condition: mapCondition(apiHour.Condition),
+		Wind: weather.Wind{
+			SpeedKph:  apiHour.WindKph,
+			SpeedMph:  apiHour.WindMph,
+			Direction: apiHour.WindDir,
+			Degree:    apiHour.WindDegree,
+			GustKph:   apiHour.GustKph,
+			GustMph:   apiHour.GustMph,
+		},
+		Pressure: weather.Pressure{
+			Millibars: apiHour.PressureMb,
+			Inches:    apiHour.PressureIn,
+		},
+		Precipitation: weather.Precipitation{
+			Millimeters: apiHour.PrecipMm,
+			Inches:      apiHour.PrecipIn,
+		},
+		SnowCm:     apiHour.SnowCm,
+		Humidity:   apiHour.Humidity,
+		CloudCover: apiHour.Cloud,
+		Visibility: weather.Distance{
+			Kilometers: apiHour.VisKm,
+			Miles:      apiHour.VisMiles,
 		},
+		UVIndex:      apiHour.UV,
+		ChanceOfRain: apiHour.ChanceOfRain,
+		ChanceOfSnow: apiHour.ChanceOfSnow,
+		WillItRain:   apiHour.WillItRain == 1,
+		WillItSnow:   apiHour.WillItSnow == 1,
+		IsDay:        apiHour.IsDay == 1,
+	}
+}
+
+func mapAstronomy(apiAstro APIAstro, date time.Time, tz *time.Location) weather.Astronomy {
+	return weather.Astronomy{
+		Sunrise:          parseAstroTime(date, apiAstro.Sunrise, tz),
+		Sunset:           parseAstroTime(date, apiAstro.Sunset, tz),
+		Moonrise:         parseAstroTime(date, apiAstro.Moonrise, tz),
+		Moonset:          parseAstroTime(date, apiAstro.Moonset, tz),
+		MoonPhase:        apiAstro.MoonPhase,
+		MoonIllumination: int(apiAstro.MoonIllumination),
+	}
+}
+
+// MapAlertsResponseToDomain converts the alerts of an external response model to domain model
+func MapAlertsResponseToDomain(apiResponse *APIWeatherResponse) *weather.WeatherAlerts {
+	return &weather.WeatherAlerts{
+		Location:  mapLocation(&apiResponse.Location),
+		Alerts:    mapAlerts(apiResponse.Alerts),
 		UpdatedAt: time.Now(),
 	}
 }
+
+func mapAlerts(apiAlerts APIAlerts) []weather.Alert {
+	if len(apiAlerts.Alert) == 0 {
+		return nil
+	}
+
+	alerts := make([]weather.Alert, 0, len(apiAlerts.Alert))
+	for _, apiAlert := range apiAlerts.Alert {
+		alerts = append(alerts, weather.Alert{
+			Headline:    apiAlert.Headline,
+			MessageType: apiAlert.MsgType,
+			Severity:    apiAlert.Severity,
+			Urgency:     apiAlert.Urgency,
+			Certainty:   apiAlert.Certainty,
+			Category:    apiAlert.Category,
+			Event:       apiAlert.Event,
+			Areas:       splitAreas(apiAlert.Areas),
+			Note:        apiAlert.Note,
+			Effective:   parseAlertTime(apiAlert.Effective),
+			Expires:     parseAlertTime(apiAlert.Expires),
+			Description: apiAlert.Desc,
+			Instruction: apiAlert.Instruction,
+		})
+	}
+	return alerts
+}
+
+// splitAreas splits the semicolon-separated list of areas an alert applies to
+func splitAreas(areas string) []string {
+	var result []string
+	for _, area := range strings.Split(areas, ";") {
+		if area = strings.TrimSpace(area); area != "" {
+			result = append(result, area)
+		}
+	}
+	return result
+}
+
+// parseAlertTime parses an ISO 8601 alert timestamp, yielding the zero time when absent or malformed
+func parseAlertTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+// mapAirQuality returns nil when the response carries no air quality block (aqi=no)
+func mapAirQuality(apiAirQuality *APIAirQuality) *weather.AirQuality {
+	if apiAirQuality == nil {
+		return nil
+	}
+
+	return &weather.AirQuality{
+		CarbonMonoxide:  apiAirQuality.CO,
+		NitrogenDioxide: apiAirQuality.NO2,
+		Ozone:           apiAirQuality.O3,
+		SulphurDioxide:  apiAirQuality.SO2,
+		PM2_5:           apiAirQuality.PM2_5,
+		PM10:            apiAirQuality.PM10,
+		USEPAIndex:      apiAirQuality.USEPAIndex,
+		UKDEFRAIndex:    apiAirQuality.GBDEFRAIndex,
+	}
+}
+
+func mapLocation(apiLocation *APILocation) weather.Location {
+	return weather.Location{
+		Name:      apiLocation.Name,
+		Region:    apiLocation.Region,
+		Country:   apiLocation.Country,
+		Latitude:  apiLocation.Lat,
+		Longitude: apiLocation.Lon,
+		Timezone:  apiLocation.TzID,
+		LocalTime: time.Unix(apiLocation.LocaltimeEpoch, 0),
+	}
+}
+
+func mapCondition(apiCondition APICondition) weather.Condition {
+	return weather.Condition{
+		Text: apiCondition.Text,
+		Code: apiCondition.Code,
+		Icon: apiCondition.Icon,
+	}
+}
+
+// parseAstroTime combines a local date with a WeatherAPI clock time such as "06:45 AM"
+// Values like "No moonrise" yield the zero time
+func parseAstroTime(date time.Time, clock string, tz *time.Location) time.Time {
+	parsed, err := time.Parse("03:04 PM", strings.TrimSpace(clock))
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), parsed.Hour(), parsed.Minute(), 0, 0, tz)
+}
+
+// loadTimezone resolves an IANA timezone name, falling back to UTC when it is unknown
+func loadTimezone(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+
+	tz, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return tz
+}
@@ -1,11 +1,17 @@
 package weatherapi
 
+import (
+	"strconv"
+	"strings"
+)
+
 // API-specific response models that match the external WeatherAPI.com JSON structure
 // These models are separate from domain models to avoid polluting domain with JSON tags
 
 type APIWeatherResponse struct {
 	Location APILocation `json:"location"`
 	Current  APICurrent  `json:"current"`
+	Alerts   APIAlerts   `json:"alerts"`
 }
 
 type APILocation struct {
@@ -20,39 +26,71 @@ type APILocation struct {
 }
 
 type APICurrent struct {
-	LastUpdatedEpoch int64        `json:"last_updated_epoch"`
-	LastUpdated      string       `json:"last_updated"`
-	TempC            float64      `json:"temp_c"`
-	TempF            float64      `json:"temp_f"`
-	IsDay            int          `json:"is_day"`
-	Condition        APICondition `json:"condition"`
-	WindMph          float64      `json:"wind_mph"`
-	WindKph          float64      `json:"wind_kph"`
-	WindDegree       int          `json:"wind_degree"`
-	WindDir          string       `json:"wind_dir"`
-	PressureMb       float64      `json:"pressure_mb"`
-	PressureIn       float64      `json:"pressure_in"`
-	PrecipMm         float64      `json:"precip_mm"`
-	PrecipIn         float64      `json:"precip_in"`
-	Humidity         int          `json:"humidity"`
-	Cloud            int          `json:"cloud"`
-	FeelslikeC       float64      `json:"feelslike_c"`
-	FeelslikeF       float64      `json:"feelslike_f"`
-	WindchillC       float64      `json:"windchill_c"`
-	WindchillF       float64      `json:"windchill_f"`
-	HeatindexC       float64      `json:"heatindex_c"`
-	HeatindexF       float64      `json:"heatindex_f"`
-	DewpointC        float64      `json:"dewpoint_c"`
-	DewpointF        float64      `json:"dewpoint_f"`
-	VisKm            float64      `json:"vis_km"`
-	VisMiles         float64      `json:"vis_miles"`
-	UV               float64      `json:"uv"`
-	GustMph          float64      `json:"gust_mph"`
-	GustKph          float64      `json:"gust_kph"`
-	ShortRad         float64      `json:"short_rad"`
-	DiffRad          float64      `json:"diff_rad"`
-	DNI              float64      `json:"dni"`
-	GTI              float64      `json:"gti"`
+	LastUpdatedEpoch int64          `json:"last_updated_epoch"`
+	LastUpdated      string         `json:"last_updated"`
+	TempC            float64        `json:"temp_c"`
+	TempF            float64        `json:"temp_f"`
+	IsDay            int            `json:"is_day"`
+	Condition        APICondition   `json:"condition"`
+	WindMph          float64        `json:"wind_mph"`
+	WindKph          float64        `json:"wind_kph"`
+	WindDegree       int            `json:"wind_degree"`
+	WindDir          string         `json:"wind_dir"`
+	PressureMb       float64        `json:"pressure_mb"`
+	PressureIn       float64        `json:"pressure_in"`
+	PrecipMm         float64        `json:"precip_mm"`
+	PrecipIn         float64        `json:"precip_in"`
+	Humidity         int            `json:"humidity"`
+	Cloud            int            `json:"cloud"`
+	FeelslikeC       float64        `json:"feelslike_c"`
+	FeelslikeF       float64        `json:"feelslike_f"`
+	WindchillC       float64        `json:"windchill_c"`
+	WindchillF       float64        `json:"windchill_f"`
+	HeatindexC       float64        `json:"heatindex_c"`
+	HeatindexF       float64        `json:"heatindex_f"`
+	DewpointC        float64        `json:"dewpoint_c"`
+	DewpointF        float64        `json:"dewpoint_f"`
+	VisKm            float64        `json:"vis_km"`
+	VisMiles         float64        `json:"vis_miles"`
+	UV               float64        `json:"uv"`
+	GustMph          float64        `json:"gust_mph"`
+	GustKph          float64        `json:"gust_kph"`
+	ShortRad         float64        `json:"short_rad"`
+	DiffRad          float64        `json:"diff_rad"`
+	DNI              float64        `json:"dni"`
+	GTI              float64        `json:"gti"`
+	AirQuality       *APIAirQuality `json:"air_quality"`
+}
+
+type APIAirQuality struct {
+	CO           float64 `json:"co"`
+	NO2          float64 `json:"no2"`
+	O3           float64 `json:"o3"`
+	SO2          float64 `json:"so2"`
+	PM2_5        float64 `json:"pm2_5"`
+	PM10         float64 `json:"pm10"`
+	USEPAIndex   int     `json:"us-epa-index"`
+	GBDEFRAIndex int     `json:"gb-defra-index"`
+}
+
+type APIAlerts struct {
+	Alert []APIAlert `json:"alert"`
+}
+
+type APIAlert struct {
+	Headline    string `json:"headline"`
+	MsgType     string `json:"msgtype"`
+	Severity    string `json:"severity"`
+	Urgency     string `json:"urgency"`
+	Areas       string `json:"areas"`
+	Category    string `json:"category"`
+	Certainty   string `json:"certainty"`
+	Event       string `json:"event"`
+	Note        string `json:"note"`
+	Effective   string `json:"effective"`
+	Expires     string `json:"expires"`
+	Desc        string `json:"desc"`
+	Instruction string `json:"instruction"`
 }
 
 type APICondition struct {
@@ -60,3 +98,138 @@ type APICondition struct {
 	Icon string `json:"icon"`
 	Code int    `json:"code"`
 }
+
+type APIForecastResponse struct {
+	Location APILocation `json:"location"`
+	Current  APICurrent  `json:"current"`
+	Forecast APIForecast `json:"forecast"`
+}
+
+type APIForecast struct {
+	ForecastDay []APIForecastDay `json:"forecastday"`
+}
+
+type APIForecastDay struct {
+	Date      string    `json:"date"`
+	DateEpoch int64     `json:"date_epoch"`
+	Day       APIDay    `json:"day"`
+	Astro     APIAstro  `json:"astro"`
+	Hour      []APIHour `json:"hour"`
+}
+
+type APIDay struct {
+	MaxtempC          float64      `json:"maxtemp_c"`
+	MaxtempF          float64      `json:"maxtemp_f"`
+	MintempC          float64      `json:"mintemp_c"`
+	MintempF          float64      `json:"mintemp_f"`
+	AvgtempC          float64      `json:"avgtemp_c"`
+	AvgtempF          float64      `json:"avgtemp_f"`
+	MaxwindMph        float64      `json:"maxwind_mph"`
+	MaxwindKph        float64      `json:"maxwind_kph"`
+	TotalprecipMm     float64      `json:"totalprecip_mm"`
+	TotalprecipIn     float64      `json:"totalprecip_in"`
+	TotalsnowCm       float64      `json:"totalsnow_cm"`
+	AvgvisKm          float64      `json:"avgvis_km"`
+	AvgvisMiles       float64      `json:"avgvis_miles"`
+	Avghumidity       float64      `json:"avghumidity"`
+	DailyWillItRain   int          `json:"daily_will_it_rain"`
+	DailyChanceOfRain int          `json:"daily_chance_of_rain"`
+	DailyWillItSnow   int          `json:"daily_will_it_snow"`
+	DailyChanceOfSnow int          `json:"daily_chance_of_snow"`
+	Condition         APICondition `json:"condition"`
+	UV                float64      `json:"uv"`
+}
+
+// APISearchResult is a single entry of the search endpoint's JSON array
+type APISearchResult struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Region  string  `json:"region"`
+	Country string  `json:"country"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	URL     string  `json:"url"`
+}
+
+// APIErrorResponse is the JSON body WeatherAPI.com sends with non-OK statuses
+type APIErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type APIAstronomyResponse struct {
+	Location  APILocation  `json:"location"`
+	Astronomy APIAstronomy `json:"astronomy"`
+}
+
+type APIAstronomy struct {
+	Astro APIAstro `json:"astro"`
+}
+
+type APIAstro struct {
+	Sunrise          string      `json:"sunrise"`
+	Sunset           string      `json:"sunset"`
+	Moonrise         string      `json:"moonrise"`
+	Moonset          string      `json:"moonset"`
+	MoonPhase        string      `json:"moon_phase"`
+	MoonIllumination flexibleInt `json:"moon_illumination"`
+}
+
+type APIHour struct {
+	TimeEpoch    int64        `json:"time_epoch"`
+	Time         string       `json:"time"`
+	TempC        float64      `json:"temp_c"`
+	TempF        float64      `json:"temp_f"`
+	IsDay        int          `json:"is_day"`
+	Condition    APICondition `json:"condition"`
+	WindMph      float64      `json:"wind_mph"`
+	WindKph      float64      `json:"wind_kph"`
+	WindDegree   int          `json:"wind_degree"`
+	WindDir      string       `json:"wind_dir"`
+	PressureMb   float64      `json:"pressure_mb"`
+	PressureIn   float64      `json:"pressure_in"`
+	PrecipMm     float64      `json:"precip_mm"`
+	PrecipIn     float64      `json:"precip_in"`
+	SnowCm       float64      `json:"snow_cm"`
+	Humidity     int          `json:"humidity"`
+	Cloud        int          `json:"cloud"`
+	FeelslikeC   float64      `json:"feelslike_c"`
+	FeelslikeF   float64      `json:"feelslike_f"`
+	WindchillC   float64      `json:"windchill_c"`
+	WindchillF   float64      `json:"windchill_f"`
+	HeatindexC   float64      `json:"heatindex_c"`
+	HeatindexF   float64      `json:"heatindex_f"`
+	DewpointC    float64      `json:"dewpoint_c"`
+	DewpointF    float64      `json:"dewpoint_f"`
+	WillItRain   int          `json:"will_it_rain"`
+	ChanceOfRain int          `json:"chance_of_rain"`
+	WillItSnow   int          `json:"will_it_snow"`
+	ChanceOfSnow int          `json:"chance_of_snow"`
+	VisKm        float64      `json:"vis_km"`
+	VisMiles     float64      `json:"vis_miles"`
+	GustMph      float64      `json:"gust_mph"`
+	GustKph      float64      `json:"gust_kph"`
+	UV           float64      `json:"uv"`
+}
+
+// flexibleInt decodes integers that WeatherAPI.com sends either as JSON numbers or as quoted strings
+type flexibleInt int
+
+func (f *flexibleInt) UnmarshalJSON(data []byte) error {
+	raw := strings.Trim(string(data), `"`)
+	if raw == "" || raw == "null" {
+		*f = 0
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return err
+	}
+	*f = flexibleInt(value)
+	return nil
+}
@@ -0,0 +1,103 @@
+package weatherapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secretKey = "sup3r-s3cret+key"
+
+func TestClient_ErrorsNeverContainAPIKey(t *testing.T) {
+	hang := func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}
+
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		baseURL     string // overrides the test server URL
+		opts        []Option
+		cancel      bool
+		expectedErr error
+	}{
+		{
+			name:        "Connection refused",
+			baseURL:     "http://127.0.0.1:1/v1",
+			expectedErr: ErrFailedToFetchWeather,
+		},
+		{
+			name:        "Invalid base URL",
+			baseURL:     "http://api.example.com/v1\x7f",
+			expectedErr: ErrFailedToFetchWeather,
+		},
+		{
+			name:        "Call timeout",
+			handler:     hang,
+			opts:        []Option{WithCallTimeout(50 * time.Millisecond)},
+			expectedErr: ErrFailedToFetchWeather,
+		},
+		{
+			name:        "HTTP client timeout",
+			handler:     hang,
+			opts:        []Option{WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond})},
+			expectedErr: ErrFailedToFetchWeather,
+		},
+		{
+			name:        "Cancelled",
+			handler:     hang,
+			cancel:      true,
+			expectedErr: ErrFailedToFetchWeather,
+		},
+		{
+			name: "Error body echoing the request",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte("bad request: " + r.URL.String() + " key " + r.URL.Query().Get("key")))
+			},
+			expectedErr: ErrAPIReturnedNonOKStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL := tt.baseURL
+			if tt.handler != nil {
+				server := httptest.NewServer(tt.handler)
+				defer server.Close()
+				baseURL = server.URL
+			}
+
+			opts := append([]Option{WithRetryPolicy(fastRetries(2))}, tt.opts...)
+			client := NewClient(secretKey, baseURL, opts...)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				time.AfterFunc(20*time.Millisecond, cancel)
+			}
+
+			_, err := client.FetchWeather(ctx, nameQuery("London"))
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.NotContains(t, err.Error(), secretKey)
+			assert.NotContains(t, err.Error(), "sup3r-s3cret%2Bkey")
+		})
+	}
+}
+
+func TestClient_ScrubURL_KeepsOtherParameters(t *testing.T) {
+	client := NewClient(secretKey, "http://127.0.0.1:1/v1", WithRetryPolicy(fastRetries(1)))
+
+	_, err := client.FetchWeather(context.Background(), nameQuery("London"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "q=London")
+	assert.NotContains(t, err.Error(), "key=")
+}
@@ -0,0 +1,119 @@
+package weatherapi
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests to WeatherAPI.com are retried
+// Only failures that may succeed on a second try are retried: network errors,
+// 429 Too Many Requests and 5xx responses. Every request is a GET, so retrying
+// is always safe.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first; 1 disables retries
+	MaxAttempts int
+	// BaseDelay is the backoff ceiling before the second attempt; it doubles with each attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff, and the Retry-After delay the API may ask for
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used unless another is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+	}
+}
+
+// retryableError marks a failed attempt that may succeed if retried
+type retryableError struct {
+	err error
+	// retryAfter is the delay the API asked for with a Retry-After header, if any
+	retryAfter time.Duration
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// retryableStatus reports whether a response status is worth retrying
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+// Returns 0 when the header is absent or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// delay returns how long to wait before the attempt following a failed one
+// The backoff is exponential with full jitter, so clients that failed together
+// don't retry together. A Retry-After delay is honored as a minimum.
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	ceiling := p.backoff(attempt)
+
+	var d time.Duration
+	if ceiling > 0 {
+		d = rand.N(ceiling + 1)
+	}
+	return max(d, retryAfter)
+}
+
+// backoff returns the longest delay before the attempt following a failed one:
+// BaseDelay doubled with each attempt, capped at MaxDelay
+// Doubling stops at the cap, so large attempt numbers can't overflow.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	ceiling := min(p.BaseDelay, p.MaxDelay)
+	for n := 1; n < attempt && ceiling < p.MaxDelay; n++ {
+		ceiling = min(2*ceiling, p.MaxDelay)
+	}
+	return ceiling
+}
+
+// retry calls attempt until it succeeds, fails for good or the policy is exhausted
+// It stops early when the wait before the next attempt would outlast the context
+// deadline, or exceed MaxDelay because of Retry-After, returning the last error.
+func (p RetryPolicy) retry(ctx context.Context, attempt func() error) error {
+	for n := 1; ; n++ {
+		err := attempt()
+
+		var retryable *retryableError
+		if !errors.As(err, &retryable) {
+			return err
+		}
+		if n >= p.MaxAttempts {
+			return retryable.err
+		}
+
+		wait := p.delay(n, retryable.retryAfter)
+		if wait > p.MaxDelay {
+			return retryable.err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return retryable.err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return retryable.err
+		case <-timer.C:
+		}
+	}
+}
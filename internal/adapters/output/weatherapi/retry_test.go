@@ -0,0 +1,221 @@
+package weatherapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetries retries quickly so tests don't wait on backoff
+func fastRetries(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    2 * time.Second,
+	}
+}
+
+// newFlakyServer fails the first failures requests with fail, then answers a location search
+func newFlakyServer(t *testing.T, failures int32, fail http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			fail(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"name": "London"}]`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// withStatus fails requests with a status code
+func withStatus(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte("upstream error"))
+	}
+}
+
+func TestClient_Retry_RecoversFromTransientFailures(t *testing.T) {
+	tests := []struct {
+		name string
+		fail http.HandlerFunc
+	}{
+		{name: "Bad gateway", fail: withStatus(http.StatusBadGateway)},
+		{name: "Service unavailable", fail: withStatus(http.StatusServiceUnavailable)},
+		{name: "Too many requests", fail: withStatus(http.StatusTooManyRequests)},
+		{
+			name: "Connection reset",
+			fail: func(w http.ResponseWriter, r *http.Request) {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				require.NoError(t, err)
+				conn.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newFlakyServer(t, 2, tt.fail)
+			client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(3)))
+
+			result, err := client.SearchLocations(context.Background(), "London")
+
+			require.NoError(t, err)
+			require.Len(t, result.Candidates, 1)
+			assert.Equal(t, "London", result.Candidates[0].Name)
+			assert.Equal(t, int32(3), calls.Load())
+		})
+	}
+}
+
+func TestClient_Retry_GivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := newFlakyServer(t, 5, withStatus(http.StatusInternalServerError))
+	client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(3)))
+
+	result, err := client.SearchLocations(context.Background(), "London")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.Contains(t, err.Error(), "status 500")
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_Retry_DoesNotRetryClientErrors(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, withStatus(http.StatusBadRequest))
+	client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(3)))
+
+	_, err := client.SearchLocations(context.Background(), "London")
+
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_Retry_Disabled(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, withStatus(http.StatusBadGateway))
+	client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(1)))
+
+	_, err := client.SearchLocations(context.Background(), "London")
+
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_Retry_HonorsRetryAfter(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(2)))
+
+	start := time.Now()
+	_, err := client.SearchLocations(context.Background(), "London")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestClient_Retry_RetryAfterBeyondMaxDelay(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(3)))
+
+	start := time.Now()
+	_, err := client.SearchLocations(context.Background(), "London")
+
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_Retry_RespectsContextDeadline(t *testing.T) {
+	server, calls := newFlakyServer(t, 5, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := NewClient("test-key", server.URL, WithRetryPolicy(fastRetries(3)))
+
+	// The deadline comes before the API would accept a retry
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.SearchLocations(ctx, "London")
+
+	assert.ErrorIs(t, err, ErrAPIReturnedNonOKStatus)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestClient_Retry_StopsWhenCancelled(t *testing.T) {
+	server, calls := newFlakyServer(t, 5, withStatus(http.StatusBadGateway))
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Second}
+	client := NewClient("test-key", server.URL, WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.SearchLocations(ctx, "London")
+
+	assert.Error(t, err)
+	assert.LessOrEqual(t, calls.Load(), int32(2))
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	for range 100 {
+		assert.LessOrEqual(t, policy.delay(1, 0), 100*time.Millisecond)
+		assert.LessOrEqual(t, policy.delay(2, 0), 200*time.Millisecond)
+		assert.LessOrEqual(t, policy.delay(4, 0), 300*time.Millisecond, "backoff is capped")
+		assert.GreaterOrEqual(t, policy.delay(1, 250*time.Millisecond), 250*time.Millisecond, "Retry-After is a minimum")
+	}
+}
+
+func TestRetryPolicy_Backoff_LargeAttempt(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 100, BaseDelay: time.Second, MaxDelay: 30 * time.Second}
+
+	assert.Equal(t, time.Second, policy.backoff(1))
+	assert.Equal(t, 16*time.Second, policy.backoff(5))
+	for _, attempt := range []int{6, 30, 35, 64, 99} {
+		assert.Equal(t, 30*time.Second, policy.backoff(attempt), "attempt %d", attempt)
+	}
+	for range 100 {
+		d := policy.delay(64, 0)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 30*time.Second)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 2, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "Absent", value: "", expected: 0},
+		{name: "Seconds", value: "5", expected: 5 * time.Second},
+		{name: "HTTP date", value: "Tue, 10 Feb 2026 15:00:30 GMT", expected: 30 * time.Second},
+		{name: "Date in the past", value: "Tue, 10 Feb 2026 14:00:00 GMT", expected: 0},
+		{name: "Invalid", value: "soon", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseRetryAfter(tt.value, now))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package redact
+
+import (
+	"io"
+	"net/url"
+	"strings"
+)
+
+// Placeholder replaces secrets in redacted text
+const Placeholder = "[REDACTED]"
+
+// Redactor removes secrets, such as API keys, from text, errors and log output
+// Secrets are matched as is and in their URL-encoded form, since they often
+// end up in request URLs. The zero value redacts nothing.
+type Redactor struct {
+	replacer *strings.Replacer
+}
+
+// New creates a redactor for the given secrets; empty secrets are ignored
+func New(secrets ...string) *Redactor {
+	var pairs []string
+	seen := make(map[string]bool)
+	for _, secret := range secrets {
+		for _, form := range []string{secret, url.QueryEscape(secret), url.PathEscape(secret)} {
+			if form == "" || seen[form] {
+				continue
+			}
+			seen[form] = true
+			pairs = append(pairs, form, Placeholder)
+		}
+	}
+	if len(pairs) == 0 {
+		return &Redactor{}
+	}
+	return &Redactor{replacer: strings.NewReplacer(pairs...)}
+}
+
+// String returns s with every secret replaced by Placeholder
+func (r *Redactor) String(s string) string {
+	if r == nil || r.replacer == nil {
+		return s
+	}
+	return r.replacer.Replace(s)
+}
+
+// Error returns err with secrets removed from its message
+// The result still wraps err, so errors.Is and errors.As see through it.
+func (r *Redactor) Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	if redacted := r.String(msg); redacted != msg {
+		return &redactedError{err: err, msg: redacted}
+	}
+	return err
+}
+
+// Writer returns a writer that redacts secrets before writing to w, e.g. for log.SetOutput
+// Each write is redacted on its own, so secrets split across writes are not caught;
+// the log package writes each entry at once.
+func (r *Redactor) Writer(w io.Writer) io.Writer {
+	return &writer{redactor: r, w: w}
+}
+
+// redactedError is an error whose message had secrets removed
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string { return e.msg }
+func (e *redactedError) Unwrap() error { return e.err }
+
+// writer redacts secrets from everything written through it
+type writer struct {
+	redactor *Redactor
+	w        io.Writer
+}
+
+// Write redacts p and writes it, reporting all of p as written on success
+func (w *writer) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.w, w.redactor.String(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
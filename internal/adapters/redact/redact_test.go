@@ -0,0 +1,63 @@
+package redact
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactor_String(t *testing.T) {
+	r := New("s3cr3t+key/1", "")
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Raw", input: "key is s3cr3t+key/1", expected: "key is [REDACTED]"},
+		{name: "Query-encoded", input: "GET /v1/forecast.json?key=s3cr3t%2Bkey%2F1&q=London", expected: "GET /v1/forecast.json?key=[REDACTED]&q=London"},
+		{name: "Path-encoded", input: "/keys/s3cr3t+key%2F1", expected: "/keys/[REDACTED]"},
+		{name: "No secret", input: "nothing to hide", expected: "nothing to hide"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, r.String(tt.input))
+		})
+	}
+}
+
+func TestRedactor_NoSecrets(t *testing.T) {
+	assert.Equal(t, "key=", New("").String("key="))
+	assert.Equal(t, "key=abc", (*Redactor)(nil).String("key=abc"))
+}
+
+func TestRedactor_Error(t *testing.T) {
+	sentinel := errors.New("failed to fetch weather data")
+	err := fmt.Errorf("%w: Get \"https://api.example.com/v1?key=abc123\": connection refused", sentinel)
+
+	redacted := New("abc123").Error(err)
+
+	assert.EqualError(t, redacted, `failed to fetch weather data: Get "https://api.example.com/v1?key=[REDACTED]": connection refused`)
+	assert.ErrorIs(t, redacted, sentinel)
+}
+
+func TestRedactor_Error_Unchanged(t *testing.T) {
+	err := errors.New("connection refused")
+
+	assert.Same(t, err, New("abc123").Error(err))
+	assert.NoError(t, New("abc123").Error(nil))
+}
+
+func TestRedactor_Writer(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.New(New("abc123").Writer(&buf), "", 0)
+
+	logger.Printf("Warning: failed to revalidate: Get \"https://api.example.com/v1?key=abc123\": EOF")
+
+	assert.Equal(t, "Warning: failed to revalidate: Get \"https://api.example.com/v1?key=[REDACTED]\": EOF\n", buf.String())
+}
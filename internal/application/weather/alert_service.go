@@ -0,0 +1,74 @@
+package weather
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// AlertService implements the GetAlertsUseCase use case
+// It orchestrates alert retrieval using a cache-aside pattern
+type AlertService struct {
+	alertProvider output.AlertProvider
+	cache         output.AlertCache
+	options
+}
+
+// NewAlertService creates a new alert application service
+func NewAlertService(provider output.AlertProvider, cache output.AlertCache, opts ...Option) *AlertService {
+	return &AlertService{
+		alertProvider: provider,
+		cache:         cache,
+		options:       newOptions(opts),
+	}
+}
+
+// GetAlerts retrieves the unexpired weather alerts for a given location
+// It follows the same cache-aside flow as Service.GetWeather and drops
+// alerts that expired while the entry was cached
+func (s *AlertService) GetAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error) {
+	// Domain validation
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := alertCacheKey(query)
+
+	alerts, err := s.cache.Get(ctx, key)
+	if err == nil && alerts != nil {
+		log.Printf("Cache hit for alerts: %s", key)
+		s.stats.hit(DataAlerts)
+	} else {
+		// Cache miss - fetch from alert provider
+		log.Printf("Cache miss for alerts: %s", key)
+		s.stats.miss(DataAlerts)
+		s.stats.upstreamCall(DataAlerts, locationBucket(query))
+		alerts, err = s.alertProvider.FetchAlerts(ctx, query)
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		// Update the timestamp
+		alerts.UpdatedAt = time.Now()
+
+		// Store in cache (non-blocking - don't fail the request if caching fails)
+		if err := s.cache.Set(ctx, key, alerts, s.ttlPolicy.TTL(DataAlerts, time.Time{}, alerts.UpdatedAt)); err != nil {
+			log.Printf("Warning: failed to cache alerts for %s: %v", key, err)
+			s.stats.setFailure(DataAlerts)
+		}
+	}
+
+	// Copy before filtering so the cached value is never modified
+	result := *alerts
+	result.Alerts = alerts.Unexpired(time.Now())
+
+	return &result, nil
+}
+
+// alertCacheKey builds the cache key for the alerts of a location
+func alertCacheKey(query weather.LocationQuery) string {
+	return "alerts:" + canonicalLocation(query)
+}
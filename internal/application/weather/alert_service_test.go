@@ -0,0 +1,152 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockAlertProvider struct {
+	mock.Mock
+}
+
+func (m *MockAlertProvider) FetchAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.WeatherAlerts), args.Error(1)
+}
+
+type MockAlertCache struct {
+	mock.Mock
+}
+
+func (m *MockAlertCache) Get(ctx context.Context, key string) (*weather.WeatherAlerts, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.WeatherAlerts), args.Error(1)
+}
+
+func (m *MockAlertCache) Set(ctx context.Context, key string, data *weather.WeatherAlerts, ttl time.Duration) error {
+	args := m.Called(ctx, key, data, ttl)
+	return args.Error(0)
+}
+
+func createSampleAlerts(locationName string, alerts ...weather.Alert) *weather.WeatherAlerts {
+	return &weather.WeatherAlerts{
+		Location: weather.Location{
+			Name:    locationName,
+			Country: "USA",
+		},
+		Alerts:    alerts,
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestGetAlerts_CacheMiss_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleAlerts("Miami", weather.Alert{
+		Headline: "Hurricane Warning issued",
+		Severity: "Extreme",
+		Expires:  time.Now().Add(6 * time.Hour),
+	})
+
+	provider := new(MockAlertProvider)
+	cache := new(MockAlertCache)
+
+	cache.On("Get", ctx, "alerts:miami").Return(nil, nil)
+	provider.On("FetchAlerts", ctx, nameQuery("Miami")).Return(expected, nil)
+	cache.On("Set", ctx, "alerts:miami", expected, fixedTTLPolicy().Alerts).Return(nil)
+
+	service := NewAlertService(provider, cache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	result, err := service.GetAlerts(ctx, nameQuery("Miami"))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Alerts, 1)
+	assert.Equal(t, "Hurricane Warning issued", result.Alerts[0].Headline)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetAlerts_CacheHit_DropsExpiredAlerts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cached := createSampleAlerts("Miami",
+		weather.Alert{Headline: "Flood Watch", Expires: time.Now().Add(-time.Minute)},
+		weather.Alert{Headline: "Heat Advisory", Expires: time.Now().Add(time.Hour)},
+	)
+
+	provider := new(MockAlertProvider)
+	cache := new(MockAlertCache)
+
+	cache.On("Get", ctx, "alerts:miami").Return(cached, nil)
+
+	service := NewAlertService(provider, cache)
+
+	// Act
+	result, err := service.GetAlerts(ctx, nameQuery("Miami"))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Alerts, 1)
+	assert.Equal(t, "Heat Advisory", result.Alerts[0].Headline)
+	// The cached value itself is left untouched
+	assert.Len(t, cached.Alerts, 2)
+
+	provider.AssertNotCalled(t, "FetchAlerts", mock.Anything, mock.Anything)
+}
+
+func TestGetAlerts_ProviderError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	provider := new(MockAlertProvider)
+	cache := new(MockAlertCache)
+
+	cache.On("Get", ctx, "alerts:miami").Return(nil, errors.New("cache miss"))
+	provider.On("FetchAlerts", ctx, nameQuery("Miami")).Return(nil, errors.New("api down"))
+
+	service := NewAlertService(provider, cache)
+
+	// Act
+	result, err := service.GetAlerts(ctx, nameQuery("Miami"))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetAlerts_InvalidLocation(t *testing.T) {
+	// Arrange
+	provider := new(MockAlertProvider)
+	cache := new(MockAlertCache)
+
+	service := NewAlertService(provider, cache)
+
+	// Act
+	result, err := service.GetAlerts(context.Background(), nameQuery(" "))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrInvalidLocation)
+
+	cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	provider.AssertNotCalled(t, "FetchAlerts", mock.Anything, mock.Anything)
+}
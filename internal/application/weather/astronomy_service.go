@@ -0,0 +1,121 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// AstronomyService implements the GetAstronomyUseCase use case
+// It orchestrates astronomy retrieval using a cache-aside pattern and falls
+// back to a local calculation when the provider is unavailable
+type AstronomyService struct {
+	astronomyProvider output.AstronomyProvider
+	cache             output.AstronomyCache
+	weatherCache      output.WeatherCache
+	options
+}
+
+// NewAstronomyService creates a new astronomy application service
+// The weather cache is only read, to find the coordinates of a location for the local fallback
+func NewAstronomyService(provider output.AstronomyProvider, cache output.AstronomyCache, weatherCache output.WeatherCache, opts ...Option) *AstronomyService {
+	return &AstronomyService{
+		astronomyProvider: provider,
+		cache:             cache,
+		weatherCache:      weatherCache,
+		options:           newOptions(opts),
+	}
+}
+
+// GetAstronomy retrieves astronomy data for a location and date
+// It follows the same cache-aside flow as Service.GetWeather. If the provider
+// fails and the coordinates of the location are known, sun times and moon
+// phase are computed locally and the report is flagged as estimated.
+func (s *AstronomyService) GetAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error) {
+	// Domain validation
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := astronomyCacheKey(query, date)
+
+	// Try to get from cache first
+	cachedReport, err := s.cache.Get(ctx, key)
+	if err == nil && cachedReport != nil {
+		log.Printf("Cache hit for astronomy: %s", key)
+		s.stats.hit(DataAstronomy)
+		return cachedReport, nil
+	}
+
+	// Cache miss - fetch from astronomy provider
+	log.Printf("Cache miss for astronomy: %s", key)
+	s.stats.miss(DataAstronomy)
+	s.stats.upstreamCall(DataAstronomy, locationBucket(query))
+	report, err := s.astronomyProvider.FetchAstronomy(ctx, query, date)
+	if err != nil {
+		if estimated, ok := s.estimate(ctx, query, date); ok {
+			log.Printf("Astronomy provider failed for %s, serving local estimate: %v", key, err)
+			return estimated, nil
+		}
+		return nil, providerError(err)
+	}
+
+	// Update the timestamp
+	report.UpdatedAt = time.Now()
+
+	// Store in cache (non-blocking - don't fail the request if caching fails)
+	if err := s.cache.Set(ctx, key, report, s.ttlPolicy.TTL(DataAstronomy, time.Time{}, report.UpdatedAt)); err != nil {
+		log.Printf("Warning: failed to cache astronomy for %s: %v", key, err)
+		s.stats.setFailure(DataAstronomy)
+	}
+
+	return report, nil
+}
+
+// estimate computes astronomy data locally from the coordinates of a location
+// Coordinates come from the query itself, or from cached current weather.
+// Estimates are not cached.
+func (s *AstronomyService) estimate(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, bool) {
+	loc, ok := s.resolveCoordinates(ctx, query)
+	if !ok {
+		return nil, false
+	}
+
+	tz := time.UTC
+	if loc.Timezone != "" {
+		if resolved, err := time.LoadLocation(loc.Timezone); err == nil {
+			tz = resolved
+		}
+	}
+	localDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, tz)
+
+	return &weather.AstronomyReport{
+		Location:  loc,
+		Date:      localDate,
+		Astronomy: weather.CalculateAstronomy(loc.Latitude, loc.Longitude, localDate),
+		Estimated: true,
+		UpdatedAt: time.Now(),
+	}, true
+}
+
+// resolveCoordinates finds the geographic position of a location without calling the provider
+func (s *AstronomyService) resolveCoordinates(ctx context.Context, query weather.LocationQuery) (weather.Location, bool) {
+	if query.HasCoordinates() {
+		return weather.Location{Name: query.String(), Latitude: query.Latitude, Longitude: query.Longitude}, true
+	}
+
+	if cached, err := s.weatherCache.Get(ctx, currentCacheKey(query)); err == nil && cached != nil {
+		return cached.Location, true
+	}
+
+	return weather.Location{}, false
+}
+
+// astronomyCacheKey builds the cache key for the astronomy data of a location and date
+func astronomyCacheKey(query weather.LocationQuery, date time.Time) string {
+	return fmt.Sprintf("astronomy:%s:%s", canonicalLocation(query), date.Format(weather.DateLayout))
+}
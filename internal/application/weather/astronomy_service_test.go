@@ -0,0 +1,213 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockAstronomyProvider struct {
+	mock.Mock
+}
+
+func (m *MockAstronomyProvider) FetchAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error) {
+	args := m.Called(ctx, query, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.AstronomyReport), args.Error(1)
+}
+
+type MockAstronomyCache struct {
+	mock.Mock
+}
+
+func (m *MockAstronomyCache) Get(ctx context.Context, key string) (*weather.AstronomyReport, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.AstronomyReport), args.Error(1)
+}
+
+func (m *MockAstronomyCache) Set(ctx context.Context, key string, data *weather.AstronomyReport, ttl time.Duration) error {
+	args := m.Called(ctx, key, data, ttl)
+	return args.Error(0)
+}
+
+var solstice = time.Date(2026, 6, 21, 0, 0, 0, 0, time.UTC)
+
+func createSampleAstronomyReport(locationName string) *weather.AstronomyReport {
+	return &weather.AstronomyReport{
+		Location: weather.Location{Name: locationName},
+		Date:     solstice,
+		Astronomy: weather.Astronomy{
+			Sunrise:   time.Date(2026, 6, 21, 3, 43, 0, 0, time.UTC),
+			Sunset:    time.Date(2026, 6, 21, 20, 21, 0, 0, time.UTC),
+			MoonPhase: "First Quarter",
+		},
+	}
+}
+
+func TestGetAstronomy_CacheHit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleAstronomyReport("London")
+
+	provider := new(MockAstronomyProvider)
+	cache := new(MockAstronomyCache)
+	weatherCache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "astronomy:london:2026-06-21").Return(expected, nil)
+
+	service := NewAstronomyService(provider, cache, weatherCache)
+
+	// Act
+	result, err := service.GetAstronomy(ctx, nameQuery("London"), solstice)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	provider.AssertNotCalled(t, "FetchAstronomy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetAstronomy_CacheMiss_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleAstronomyReport("London")
+
+	provider := new(MockAstronomyProvider)
+	cache := new(MockAstronomyCache)
+	weatherCache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "astronomy:london:2026-06-21").Return(nil, nil)
+	provider.On("FetchAstronomy", ctx, nameQuery("London"), solstice).Return(expected, nil)
+	cache.On("Set", ctx, "astronomy:london:2026-06-21", expected, fixedTTLPolicy().Astronomy).Return(nil)
+
+	service := NewAstronomyService(provider, cache, weatherCache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	result, err := service.GetAstronomy(ctx, nameQuery("London"), solstice)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.Estimated)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetAstronomy_ProviderError_FallbackFromCachedWeather(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	provider := new(MockAstronomyProvider)
+	cache := new(MockAstronomyCache)
+	weatherCache := new(MockWeatherCache)
+
+	cachedWeather := createSampleWeather("London", 18.0)
+	cachedWeather.Location.Latitude = 51.52
+	cachedWeather.Location.Longitude = -0.11
+	cachedWeather.Location.Timezone = "UTC"
+
+	cache.On("Get", ctx, "astronomy:london:2026-06-21").Return(nil, nil)
+	provider.On("FetchAstronomy", ctx, nameQuery("London"), solstice).Return(nil, errors.New("api down"))
+	weatherCache.On("Get", ctx, "current:london").Return(cachedWeather, nil)
+
+	service := NewAstronomyService(provider, cache, weatherCache)
+
+	// Act
+	result, err := service.GetAstronomy(ctx, nameQuery("London"), solstice)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Estimated)
+	assert.Equal(t, "London", result.Location.Name)
+
+	// London on the summer solstice: sunrise ~03:43 UTC, sunset ~20:21 UTC
+	assert.Equal(t, 3, result.Astronomy.Sunrise.Hour())
+	assert.InDelta(t, 43, result.Astronomy.Sunrise.Minute(), 2)
+	assert.Equal(t, 20, result.Astronomy.Sunset.Hour())
+	assert.InDelta(t, 21, result.Astronomy.Sunset.Minute(), 2)
+	assert.NotEmpty(t, result.Astronomy.MoonPhase)
+
+	// Estimates are never cached
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetAstronomy_ProviderError_FallbackFromCoordinates(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByCoordinates, Latitude: 78.22, Longitude: 15.65} // Longyearbyen, midnight sun in June
+
+	provider := new(MockAstronomyProvider)
+	cache := new(MockAstronomyCache)
+	weatherCache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, mock.Anything).Return(nil, nil)
+	provider.On("FetchAstronomy", ctx, query, solstice).Return(nil, errors.New("api down"))
+
+	service := NewAstronomyService(provider, cache, weatherCache)
+
+	// Act
+	result, err := service.GetAstronomy(ctx, query, solstice)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Estimated)
+	assert.Equal(t, 78.22, result.Location.Latitude)
+	// The sun never sets
+	assert.True(t, result.Astronomy.Sunrise.IsZero())
+	assert.True(t, result.Astronomy.Sunset.IsZero())
+
+	// Coordinates come from the query, so cached weather is not consulted
+	weatherCache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestGetAstronomy_ProviderError_NoFallback(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	provider := new(MockAstronomyProvider)
+	cache := new(MockAstronomyCache)
+	weatherCache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, mock.Anything).Return(nil, errors.New("cache miss"))
+	provider.On("FetchAstronomy", ctx, nameQuery("Atlantis"), solstice).Return(nil, errors.New("api down"))
+	weatherCache.On("Get", ctx, "current:atlantis").Return(nil, nil)
+
+	service := NewAstronomyService(provider, cache, weatherCache)
+
+	// Act
+	result, err := service.GetAstronomy(ctx, nameQuery("Atlantis"), solstice)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+}
+
+func TestGetAstronomy_InvalidLocation(t *testing.T) {
+	// Arrange
+	provider := new(MockAstronomyProvider)
+	cache := new(MockAstronomyCache)
+	weatherCache := new(MockWeatherCache)
+
+	service := NewAstronomyService(provider, cache, weatherCache)
+
+	// Act
+	result, err := service.GetAstronomy(context.Background(), nameQuery(""), solstice)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrInvalidLocation)
+
+	cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
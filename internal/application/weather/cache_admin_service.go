@@ -0,0 +1,194 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// currentKeyPrefix starts every current weather cache key
+const currentKeyPrefix = "current:"
+
+// deleteBatchSize bounds the number of keys removed per cache round-trip when flushing
+const deleteBatchSize = 500
+
+// CacheAdminService implements the CacheAdminUseCase use case
+// It inspects and invalidates cache entries through the weather cache port,
+// and the caches of the other data types
+type CacheAdminService struct {
+	cache output.WeatherCache
+	// caches holds every cache, the weather cache first
+	caches []output.ManagedCache
+}
+
+// NewCacheAdminService creates a new cache administration service
+// others are the caches of the other data types (forecasts, history, ...). They
+// may share a store with the weather cache, as on Redis, or be separate, as in
+// memory: entries are looked up in and removed from all of them either way.
+func NewCacheAdminService(cache output.WeatherCache, others ...output.ManagedCache) *CacheAdminService {
+	return &CacheAdminService{
+		cache:  cache,
+		caches: append([]output.ManagedCache{cache}, others...),
+	}
+}
+
+// ListCachedWeather lists the current weather entries whose keys start with "current:" + prefix
+// Entries are sorted by key; aliases carry the data of the entry they point to.
+// Listing neither warms the cache nor skews its statistics.
+func (s *CacheAdminService) ListCachedWeather(ctx context.Context, prefix string) ([]weather.CachedWeather, error) {
+	cached, err := s.cache.List(ctx, currentKeyPrefix+prefix)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, err)
+	}
+	slices.SortFunc(cached, func(a, b weather.CachedWeather) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return cached, nil
+}
+
+// GetCacheEntry returns the entry stored under a cache key, in whichever cache holds it
+// Only current weather entries carry their data; other data types are described without it.
+func (s *CacheAdminService) GetCacheEntry(ctx context.Context, key string) (*weather.CachedWeather, error) {
+	var entry *weather.CacheEntry
+	for _, cache := range s.caches {
+		var err error
+		if entry, err = cache.Inspect(ctx, key); err != nil {
+			return nil, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, err)
+		}
+		if entry != nil {
+			break
+		}
+	}
+	if entry == nil {
+		return nil, weather.ErrCacheEntryNotFound
+	}
+
+	cached := &weather.CachedWeather{CacheEntry: *entry}
+	if strings.HasPrefix(key, currentKeyPrefix) {
+		var err error
+		if cached.Value, err = s.cache.Peek(ctx, key); err != nil {
+			return nil, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, err)
+		}
+	}
+	return cached, nil
+}
+
+// InvalidateLocation removes every cached entry for a location: its current weather,
+// the entry its name resolved to, and its forecasts, history, alerts and astronomy data
+func (s *CacheAdminService) InvalidateLocation(ctx context.Context, query weather.LocationQuery) (int, error) {
+	// Domain validation
+	if err := query.Validate(); err != nil {
+		return 0, err
+	}
+
+	key := currentCacheKey(query)
+	keys := []string{key, alertCacheKey(query)}
+
+	entry, err := s.cache.Inspect(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, err)
+	}
+	if entry != nil && entry.AliasOf != "" {
+		keys = append(keys, entry.AliasOf)
+	}
+
+	location := canonicalLocation(query)
+	for _, prefix := range []string{"forecast:", "history:", "astronomy:"} {
+		scanned, err := s.scan(ctx, prefix+location+":")
+		if err != nil {
+			return 0, err
+		}
+		keys = append(keys, scanned...)
+	}
+
+	deleted, err := s.delete(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("Invalidated %d cache entries for location: %s", deleted, key)
+	return deleted, nil
+}
+
+// InvalidatePrefix removes the entries whose keys start with prefix
+// An empty prefix is rejected; use FlushCache to remove everything.
+func (s *CacheAdminService) InvalidatePrefix(ctx context.Context, prefix string) (int, error) {
+	if prefix == "" {
+		return 0, weather.ErrInvalidCachePrefix
+	}
+
+	deleted, err := s.deleteMatching(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("Invalidated %d cache entries with prefix: %s", deleted, prefix)
+	return deleted, nil
+}
+
+// FlushCache removes every cached entry
+func (s *CacheAdminService) FlushCache(ctx context.Context) (int, error) {
+	deleted, err := s.deleteMatching(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("Flushed %d cache entries", deleted)
+	return deleted, nil
+}
+
+// deleteMatching removes the entries whose keys start with prefix, in batches
+func (s *CacheAdminService) deleteMatching(ctx context.Context, prefix string) (int, error) {
+	keys, err := s.scan(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for batch := range slices.Chunk(keys, deleteBatchSize) {
+		n, err := s.delete(ctx, batch)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// scan lists the keys starting with prefix across every cache
+// Caches sharing a store list the same keys, so each store is scanned once.
+func (s *CacheAdminService) scan(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	scanned := make(map[string]bool)
+	for _, cache := range s.caches {
+		namespace := cache.Namespace()
+		if scanned[namespace] {
+			continue
+		}
+		scanned[namespace] = true
+
+		found, err := cache.Keys(ctx, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, err)
+		}
+		keys = append(keys, found...)
+	}
+	return keys, nil
+}
+
+// delete removes keys from every cache and returns how many existed
+// A key is counted once: caches sharing a store find it gone after the first
+// removal, but still drop their local copies.
+func (s *CacheAdminService) delete(ctx context.Context, keys []string) (int, error) {
+	deleted := 0
+	for _, cache := range s.caches {
+		n, err := cache.Delete(ctx, keys...)
+		deleted += n
+		if err != nil {
+			return deleted, fmt.Errorf("%w: %v", weather.ErrCacheUnavailable, err)
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,265 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestListCachedWeather(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	london := createSampleWeather("London", 15)
+	mockCache.On("List", mock.Anything, "current:lon").Return([]weather.CachedWeather{
+		{CacheEntry: weather.CacheEntry{Key: "current:long beach", TTL: time.Hour}},
+		{CacheEntry: weather.CacheEntry{Key: "current:london", AliasOf: "current:51.52,-0.11", TTL: 24 * time.Hour}, Value: london},
+	}, nil)
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	result, err := service.ListCachedWeather(context.Background(), "lon")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "current:london", result[0].Key)
+	assert.Same(t, london, result[0].Value)
+	assert.Equal(t, "current:long beach", result[1].Key)
+	assert.Nil(t, result[1].Value)
+	mockCache.AssertNotCalled(t, "Peek", mock.Anything, mock.Anything)
+}
+
+func TestListCachedWeather_CacheError(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	mockCache.On("List", mock.Anything, "current:").Return(nil, errors.New("connection refused"))
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	result, err := service.ListCachedWeather(context.Background(), "")
+
+	// Assert
+	assert.ErrorIs(t, err, weather.ErrCacheUnavailable)
+	assert.Nil(t, result)
+}
+
+func TestGetCacheEntry(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	london := createSampleWeather("London", 15)
+	mockCache.On("Inspect", mock.Anything, "current:london").Return(&weather.CacheEntry{Key: "current:london", TTL: time.Hour}, nil)
+	mockCache.On("Peek", mock.Anything, "current:london").Return(london, nil)
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	result, err := service.GetCacheEntry(context.Background(), "current:london")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, result.TTL)
+	assert.Same(t, london, result.Value)
+}
+
+func TestGetCacheEntry_OtherDataType_HasNoWeather(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Inspect", mock.Anything, "forecast:london:3").Return(&weather.CacheEntry{Key: "forecast:london:3"}, nil)
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	result, err := service.GetCacheEntry(context.Background(), "forecast:london:3")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, result.Value)
+	mockCache.AssertNotCalled(t, "Peek", mock.Anything, mock.Anything)
+}
+
+func TestGetCacheEntry_NotFound(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Inspect", mock.Anything, "current:atlantis").Return(nil, nil)
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	result, err := service.GetCacheEntry(context.Background(), "current:atlantis")
+
+	// Assert
+	assert.ErrorIs(t, err, weather.ErrCacheEntryNotFound)
+	assert.Nil(t, result)
+}
+
+func TestInvalidateLocation(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Inspect", mock.Anything, "current:london").Return(&weather.CacheEntry{Key: "current:london", AliasOf: "current:51.52,-0.11"}, nil)
+	mockCache.On("Namespace").Return("redis")
+	mockCache.On("Keys", mock.Anything, "forecast:london:").Return([]string{"forecast:london:3"}, nil)
+	mockCache.On("Keys", mock.Anything, "history:london:").Return(nil, nil)
+	mockCache.On("Keys", mock.Anything, "astronomy:london:").Return([]string{"astronomy:london:2026-03-02"}, nil)
+	mockCache.On("Delete", mock.Anything, []string{
+		"current:london",
+		"alerts:london",
+		"current:51.52,-0.11",
+		"forecast:london:3",
+		"astronomy:london:2026-03-02",
+	}).Return(4, nil)
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	deleted, err := service.InvalidateLocation(context.Background(), nameQuery(" LONDON "))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 4, deleted)
+	mockCache.AssertExpectations(t)
+}
+
+func TestInvalidateLocation_InvalidQuery(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	deleted, err := service.InvalidateLocation(context.Background(), nameQuery(""))
+
+	// Assert
+	assert.ErrorIs(t, err, weather.ErrInvalidLocation)
+	assert.Zero(t, deleted)
+	mockCache.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestInvalidatePrefix(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Namespace").Return("redis")
+	mockCache.On("Keys", mock.Anything, "forecast:").Return([]string{"forecast:london:3", "forecast:paris:1"}, nil)
+	mockCache.On("Delete", mock.Anything, []string{"forecast:london:3", "forecast:paris:1"}).Return(2, nil)
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	deleted, err := service.InvalidatePrefix(context.Background(), "forecast:")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+}
+
+func TestInvalidatePrefix_EmptyPrefix(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	deleted, err := service.InvalidatePrefix(context.Background(), "")
+
+	// Assert
+	assert.ErrorIs(t, err, weather.ErrInvalidCachePrefix)
+	assert.Zero(t, deleted)
+	mockCache.AssertNotCalled(t, "Keys", mock.Anything, mock.Anything)
+}
+
+func TestFlushCache_DeletesInBatches(t *testing.T) {
+	// Arrange
+	mockCache := new(MockWeatherCache)
+	keys := make([]string, deleteBatchSize+1)
+	for i := range keys {
+		keys[i] = "current:" + strconv.Itoa(i)
+	}
+	mockCache.On("Namespace").Return("redis")
+	mockCache.On("Keys", mock.Anything, "").Return(keys, nil)
+	mockCache.On("Delete", mock.Anything, mock.MatchedBy(func(keys []string) bool { return len(keys) == deleteBatchSize })).Return(deleteBatchSize, nil).Once()
+	mockCache.On("Delete", mock.Anything, mock.MatchedBy(func(keys []string) bool { return len(keys) == 1 })).Return(1, nil).Once()
+
+	service := NewCacheAdminService(mockCache)
+
+	// Act
+	deleted, err := service.FlushCache(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, deleteBatchSize+1, deleted)
+	mockCache.AssertExpectations(t)
+}
+
+func TestFlushCache_EveryCache(t *testing.T) {
+	// Arrange
+	currentCache := new(MockWeatherCache)
+	currentCache.On("Namespace").Return("memory:current")
+	currentCache.On("Keys", mock.Anything, "").Return([]string{"current:london"}, nil)
+	forecastCache := new(MockWeatherCache)
+	forecastCache.On("Namespace").Return("memory:forecast")
+	forecastCache.On("Keys", mock.Anything, "").Return([]string{"forecast:london:3"}, nil)
+	keys := []string{"current:london", "forecast:london:3"}
+	currentCache.On("Delete", mock.Anything, keys).Return(1, nil)
+	forecastCache.On("Delete", mock.Anything, keys).Return(1, nil)
+
+	service := NewCacheAdminService(currentCache, forecastCache)
+
+	// Act
+	deleted, err := service.FlushCache(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	currentCache.AssertExpectations(t)
+	forecastCache.AssertExpectations(t)
+}
+
+func TestInvalidatePrefix_SharedStore_ScansOnceAndCountsKeysOnce(t *testing.T) {
+	// Arrange
+	// Both caches share a store: it is scanned once, and the first removal deletes the keys
+	keys := []string{"forecast:london:3", "forecast:paris:1"}
+	currentCache := new(MockWeatherCache)
+	currentCache.On("Namespace").Return("redis")
+	currentCache.On("Keys", mock.Anything, "forecast:").Return(keys, nil).Once()
+	currentCache.On("Delete", mock.Anything, keys).Return(2, nil)
+	forecastCache := new(MockWeatherCache)
+	forecastCache.On("Namespace").Return("redis")
+	forecastCache.On("Delete", mock.Anything, keys).Return(0, nil)
+
+	service := NewCacheAdminService(currentCache, forecastCache)
+
+	// Act
+	deleted, err := service.InvalidatePrefix(context.Background(), "forecast:")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	currentCache.AssertExpectations(t)
+	forecastCache.AssertExpectations(t)
+	forecastCache.AssertNotCalled(t, "Keys", mock.Anything, mock.Anything)
+}
+
+func TestGetCacheEntry_OtherCache(t *testing.T) {
+	// Arrange
+	currentCache := new(MockWeatherCache)
+	currentCache.On("Inspect", mock.Anything, "forecast:london:3").Return(nil, nil)
+	forecastCache := new(MockWeatherCache)
+	forecastCache.On("Inspect", mock.Anything, "forecast:london:3").Return(&weather.CacheEntry{Key: "forecast:london:3", TTL: time.Hour}, nil)
+
+	service := NewCacheAdminService(currentCache, forecastCache)
+
+	// Act
+	result, err := service.GetCacheEntry(context.Background(), "forecast:london:3")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, result.TTL)
+	assert.Nil(t, result.Value)
+}
@@ -0,0 +1,70 @@
+package weather
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// Current weather for a place name is stored once under its resolved
+// coordinates; the name itself only points there, and that mapping rarely changes
+const aliasCacheTTL = 30 * 24 * time.Hour
+
+// caseFolder folds case for caseless matching ("Straße" and "STRASSE" fold alike)
+var caseFolder = cases.Fold()
+
+// currentCacheKey builds the cache key for the current weather of a location
+func currentCacheKey(query weather.LocationQuery) string {
+	return "current:" + canonicalLocation(query)
+}
+
+// resolvedCacheKey builds the current weather cache key for the location a provider resolved a query to
+// Returns false when the provider reported no coordinates
+func resolvedCacheKey(loc weather.Location) (string, bool) {
+	if loc.Latitude == 0 && loc.Longitude == 0 {
+		return "", false
+	}
+	return "current:" + canonicalCoordinates(loc.Latitude, loc.Longitude), true
+}
+
+// canonicalLocation reduces a location query to the form used in cache keys,
+// so that equivalent queries ("London", " london", "LONDON") share entries
+func canonicalLocation(query weather.LocationQuery) string {
+	switch query.Kind {
+	case weather.LocationByCoordinates:
+		return canonicalCoordinates(query.Latitude, query.Longitude)
+	case weather.LocationByPostcode:
+		return "postcode:" + strings.NewReplacer(" ", "", "-", "").Replace(canonicalText(query.Name))
+	case weather.LocationByIATA:
+		return "iata:" + canonicalText(query.Name)
+	case weather.LocationByIP:
+		return "ip:" + strings.ToLower(query.IP)
+	default:
+		return canonicalText(query.Name)
+	}
+}
+
+// canonicalText normalizes free text: Unicode NFKC, case-folded, trimmed and
+// with runs of whitespace collapsed to a single space
+func canonicalText(s string) string {
+	s = caseFolder.String(norm.NFKC.String(s))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// canonicalCoordinates rounds coordinates to 2 decimal places (about 1 km),
+// close enough for the weather to be the same
+func canonicalCoordinates(latitude, longitude float64) string {
+	// Adding zero turns -0 into 0 so both round to the same key
+	return fmt.Sprintf("%.2f,%.2f", roundCoordinate(latitude)+0, roundCoordinate(longitude)+0)
+}
+
+// roundCoordinate rounds a coordinate to 2 decimal places
+func roundCoordinate(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,95 @@
+package weather
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestCurrentCacheKey_EquivalentQueriesShareKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		queries []weather.LocationQuery
+		want    string
+	}{
+		{
+			name:    "case and whitespace",
+			queries: []weather.LocationQuery{nameQuery("London"), nameQuery(" london "), nameQuery("LONDON")},
+			want:    "current:london",
+		},
+		{
+			name:    "inner whitespace",
+			queries: []weather.LocationQuery{nameQuery("New York"), nameQuery("new   york"), nameQuery("New\tYork")},
+			want:    "current:new york",
+		},
+		{
+			name: "unicode composition",
+			// Precomposed ü and u followed by a combining diaeresis
+			queries: []weather.LocationQuery{nameQuery("Zürich"), nameQuery("Zürich"), nameQuery("ZÜRICH")},
+			want:    "current:zürich",
+		},
+		{
+			name: "coordinate rounding",
+			queries: []weather.LocationQuery{
+				{Kind: weather.LocationByCoordinates, Latitude: 37.9838, Longitude: 23.7275},
+				{Kind: weather.LocationByCoordinates, Latitude: 37.98, Longitude: 23.73},
+				{Kind: weather.LocationByCoordinates, Latitude: 37.97501, Longitude: 23.72999},
+			},
+			want: "current:37.98,23.73",
+		},
+		{
+			name: "negative zero",
+			queries: []weather.LocationQuery{
+				{Kind: weather.LocationByCoordinates, Latitude: 51.4769, Longitude: -0.0005},
+				{Kind: weather.LocationByCoordinates, Latitude: 51.48, Longitude: 0},
+			},
+			want: "current:51.48,0.00",
+		},
+		{
+			name: "postcode spacing",
+			queries: []weather.LocationQuery{
+				{Kind: weather.LocationByPostcode, Name: "SW1A 1AA"},
+				{Kind: weather.LocationByPostcode, Name: "SW1A1AA"},
+			},
+			want: "current:postcode:sw1a1aa",
+		},
+		{
+			name: "iata case",
+			queries: []weather.LocationQuery{
+				{Kind: weather.LocationByIATA, Name: "LHR"},
+				{Kind: weather.LocationByIATA, Name: "lhr"},
+			},
+			want: "current:iata:lhr",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, query := range tt.queries {
+				assert.Equal(t, tt.want, currentCacheKey(query))
+			}
+		})
+	}
+}
+
+func TestCurrentCacheKey_DistinctKinds(t *testing.T) {
+	// Act
+	byName := currentCacheKey(nameQuery("LHR"))
+	byIATA := currentCacheKey(weather.LocationQuery{Kind: weather.LocationByIATA, Name: "LHR"})
+
+	// Assert
+	assert.NotEqual(t, byName, byIATA)
+}
+
+func TestResolvedCacheKey(t *testing.T) {
+	// Act
+	key, ok := resolvedCacheKey(weather.Location{Name: "London", Latitude: 51.5171, Longitude: -0.1062})
+	_, unknownOK := resolvedCacheKey(weather.Location{Name: "Nowhere"})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, "current:51.52,-0.11", key)
+	assert.False(t, unknownOK)
+}
@@ -0,0 +1,76 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// ForecastService implements the GetForecastUseCase use case
+// It orchestrates forecast retrieval using a cache-aside pattern
+type ForecastService struct {
+	forecastProvider output.ForecastProvider
+	cache            output.ForecastCache
+	options
+}
+
+// NewForecastService creates a new forecast application service
+func NewForecastService(provider output.ForecastProvider, cache output.ForecastCache, opts ...Option) *ForecastService {
+	return &ForecastService{
+		forecastProvider: provider,
+		cache:            cache,
+		options:          newOptions(opts),
+	}
+}
+
+// GetForecast retrieves a multi-day forecast for a given location
+// It follows the same cache-aside flow as Service.GetWeather
+func (s *ForecastService) GetForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error) {
+	// Domain validation
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	if err := weather.ValidateForecastDays(days); err != nil {
+		return nil, err
+	}
+
+	key := forecastCacheKey(query, days)
+
+	// Try to get from cache first
+	cachedForecast, err := s.cache.Get(ctx, key)
+	if err == nil && cachedForecast != nil {
+		log.Printf("Cache hit for forecast: %s", key)
+		s.stats.hit(DataForecast)
+		return cachedForecast, nil
+	}
+
+	// Cache miss - fetch from forecast provider
+	log.Printf("Cache miss for forecast: %s", key)
+	s.stats.miss(DataForecast)
+	s.stats.upstreamCall(DataForecast, locationBucket(query))
+	forecast, err := s.forecastProvider.FetchForecast(ctx, query, days)
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	// Update the timestamp
+	forecast.UpdatedAt = time.Now()
+
+	// Store in cache (non-blocking - don't fail the request if caching fails)
+	if err := s.cache.Set(ctx, key, forecast, s.ttlPolicy.TTL(DataForecast, time.Time{}, forecast.UpdatedAt)); err != nil {
+		log.Printf("Warning: failed to cache forecast for %s: %v", key, err)
+		s.stats.setFailure(DataForecast)
+	}
+
+	return forecast, nil
+}
+
+// forecastCacheKey builds the cache key for a forecast
+// The number of days is part of the key since each request covers a different range
+func forecastCacheKey(query weather.LocationQuery, days int) string {
+	return fmt.Sprintf("forecast:%s:%d", canonicalLocation(query), days)
+}
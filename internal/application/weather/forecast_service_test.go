@@ -0,0 +1,185 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockForecastProvider struct {
+	mock.Mock
+}
+
+func (m *MockForecastProvider) FetchForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error) {
+	args := m.Called(ctx, query, days)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Forecast), args.Error(1)
+}
+
+type MockForecastCache struct {
+	mock.Mock
+}
+
+func (m *MockForecastCache) Get(ctx context.Context, key string) (*weather.Forecast, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Forecast), args.Error(1)
+}
+
+func (m *MockForecastCache) Set(ctx context.Context, key string, data *weather.Forecast, ttl time.Duration) error {
+	args := m.Called(ctx, key, data, ttl)
+	return args.Error(0)
+}
+
+func createSampleForecast(locationName string, days int) *weather.Forecast {
+	forecast := &weather.Forecast{
+		Location: weather.Location{
+			Name:    locationName,
+			Country: "Greece",
+		},
+		UpdatedAt: time.Now(),
+	}
+	for i := 0; i < days; i++ {
+		forecast.Days = append(forecast.Days, weather.DailyWeather{
+			Date:         time.Date(2026, 3, 2+i, 0, 0, 0, 0, time.UTC),
+			MaxTemp:      weather.TemperatureValue{Celsius: 20 + float64(i)},
+			MinTemp:      weather.TemperatureValue{Celsius: 10 + float64(i)},
+			ChanceOfRain: 30,
+			Condition:    weather.Condition{Text: "Sunny", Code: 1000},
+		})
+	}
+	return forecast
+}
+
+func TestGetForecast_CacheHit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleForecast("Athens", 3)
+
+	provider := new(MockForecastProvider)
+	cache := new(MockForecastCache)
+
+	cache.On("Get", ctx, "forecast:athens:3").Return(expected, nil)
+
+	service := NewForecastService(provider, cache)
+
+	// Act
+	result, err := service.GetForecast(ctx, nameQuery("Athens"), 3)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	provider.AssertNotCalled(t, "FetchForecast", mock.Anything, mock.Anything, mock.Anything)
+	cache.AssertExpectations(t)
+}
+
+func TestGetForecast_CacheMiss_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleForecast("Athens", 5)
+
+	provider := new(MockForecastProvider)
+	cache := new(MockForecastCache)
+
+	cache.On("Get", ctx, "forecast:athens:5").Return(nil, nil)
+	provider.On("FetchForecast", ctx, nameQuery("Athens"), 5).Return(expected, nil)
+	cache.On("Set", ctx, "forecast:athens:5", expected, fixedTTLPolicy().Forecast).Return(nil)
+
+	service := NewForecastService(provider, cache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	result, err := service.GetForecast(ctx, nameQuery("Athens"), 5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Days, 5)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetForecast_ProviderError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	provider := new(MockForecastProvider)
+	cache := new(MockForecastCache)
+
+	cache.On("Get", ctx, "forecast:athens:3").Return(nil, errors.New("cache miss"))
+	provider.On("FetchForecast", ctx, nameQuery("Athens"), 3).Return(nil, errors.New("api down"))
+
+	service := NewForecastService(provider, cache)
+
+	// Act
+	result, err := service.GetForecast(ctx, nameQuery("Athens"), 3)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetForecast_CacheSetError_StillReturnsData(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleForecast("Athens", 3)
+
+	provider := new(MockForecastProvider)
+	cache := new(MockForecastCache)
+
+	cache.On("Get", ctx, "forecast:athens:3").Return(nil, nil)
+	provider.On("FetchForecast", ctx, nameQuery("Athens"), 3).Return(expected, nil)
+	cache.On("Set", ctx, "forecast:athens:3", expected, fixedTTLPolicy().Forecast).Return(errors.New("cache set failed"))
+
+	service := NewForecastService(provider, cache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	result, err := service.GetForecast(ctx, nameQuery("Athens"), 3)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+}
+
+func TestGetForecast_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		location    string
+		days        int
+		expectedErr error
+	}{
+		{name: "Empty location", location: "  ", days: 3, expectedErr: weather.ErrInvalidLocation},
+		{name: "Zero days", location: "Athens", days: 0, expectedErr: weather.ErrInvalidForecastDays},
+		{name: "Too many days", location: "Athens", days: 15, expectedErr: weather.ErrInvalidForecastDays},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := new(MockForecastProvider)
+			cache := new(MockForecastCache)
+
+			service := NewForecastService(provider, cache)
+
+			result, err := service.GetForecast(context.Background(), nameQuery(tt.location), tt.days)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			// Neither cache nor provider should be called for invalid input
+			cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+			provider.AssertNotCalled(t, "FetchForecast", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package weather
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// HealthService implements the HealthCheckUseCase use case
+type HealthService struct {
+	upstreams []output.UpstreamHealthReporter
+}
+
+// NewHealthService creates a new health application service reporting on the given upstreams
+func NewHealthService(upstreams ...output.UpstreamHealthReporter) *HealthService {
+	return &HealthService{
+		upstreams: upstreams,
+	}
+}
+
+// CheckHealth reports the health of every upstream provider
+func (s *HealthService) CheckHealth(_ context.Context) (*weather.HealthReport, error) {
+	report := &weather.HealthReport{
+		Upstreams: make([]weather.UpstreamHealth, 0, len(s.upstreams)),
+	}
+	for _, upstream := range s.upstreams {
+		report.Upstreams = append(report.Upstreams, upstream.UpstreamHealth())
+	}
+	return report, nil
+}
@@ -0,0 +1,49 @@
+package weather
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// stubUpstream reports a fixed upstream health
+type stubUpstream weather.UpstreamHealth
+
+func (s stubUpstream) UpstreamHealth() weather.UpstreamHealth {
+	return weather.UpstreamHealth(s)
+}
+
+func TestCheckHealth_ReportsEveryUpstream(t *testing.T) {
+	// Arrange
+	service := NewHealthService(
+		stubUpstream{Name: "weatherapi.com", State: weather.CircuitOpen, Requests: 10, Failures: 6},
+		stubUpstream{Name: "geocoder", State: weather.CircuitClosed},
+	)
+
+	// Act
+	report, err := service.CheckHealth(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, report.Upstreams, 2)
+	assert.Equal(t, "weatherapi.com", report.Upstreams[0].Name)
+	assert.Equal(t, weather.CircuitOpen, report.Upstreams[0].State)
+	assert.True(t, report.Degraded())
+}
+
+func TestCheckHealth_NoUpstreams(t *testing.T) {
+	// Arrange
+	service := NewHealthService()
+
+	// Act
+	report, err := service.CheckHealth(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, report.Upstreams)
+	assert.False(t, report.Degraded())
+}
@@ -0,0 +1,84 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// HistoryService implements the GetHistoryUseCase use case
+// It orchestrates historical weather retrieval using a cache-aside pattern
+type HistoryService struct {
+	historyProvider output.HistoryProvider
+	cache           output.HistoryCache
+	options
+}
+
+// NewHistoryService creates a new history application service
+func NewHistoryService(provider output.HistoryProvider, cache output.HistoryCache, opts ...Option) *HistoryService {
+	return &HistoryService{
+		historyProvider: provider,
+		cache:           cache,
+		options:         newOptions(opts),
+	}
+}
+
+// GetHistory retrieves observed weather for a location between two dates, inclusive
+// It follows the same cache-aside flow as Service.GetWeather, with a TTL that
+// depends on whether the requested days are over
+func (s *HistoryService) GetHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error) {
+	// Domain validation
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	if err := weather.ValidateHistoryRange(from, to, time.Now()); err != nil {
+		return nil, err
+	}
+
+	key := historyCacheKey(query, from, to)
+
+	// Try to get from cache first
+	cachedHistory, err := s.cache.Get(ctx, key)
+	if err == nil && cachedHistory != nil {
+		log.Printf("Cache hit for history: %s", key)
+		s.stats.hit(DataHistory)
+		return cachedHistory, nil
+	}
+
+	// Cache miss - fetch from history provider
+	log.Printf("Cache miss for history: %s", key)
+	s.stats.miss(DataHistory)
+	s.stats.upstreamCall(DataHistory, locationBucket(query))
+	history, err := s.historyProvider.FetchHistory(ctx, query, from, to)
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	// Update the timestamp
+	history.UpdatedAt = time.Now()
+
+	// Store in cache (non-blocking - don't fail the request if caching fails)
+	if err := s.cache.Set(ctx, key, history, s.ttlPolicy.TTL(historyDataType(history, history.UpdatedAt), time.Time{}, history.UpdatedAt)); err != nil {
+		log.Printf("Warning: failed to cache history for %s: %v", key, err)
+		s.stats.setFailure(DataHistory)
+	}
+
+	return history, nil
+}
+
+// historyDataType picks the cache lifetime of a history entry based on whether it can still change
+func historyDataType(history *weather.History, now time.Time) DataType {
+	if history.IsFinal(now) {
+		return DataHistory
+	}
+	return DataRecentHistory
+}
+
+// historyCacheKey builds the cache key for a historical date range
+func historyCacheKey(query weather.LocationQuery, from, to time.Time) string {
+	return fmt.Sprintf("history:%s:%s:%s", canonicalLocation(query), from.Format(weather.DateLayout), to.Format(weather.DateLayout))
+}
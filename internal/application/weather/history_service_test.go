@@ -0,0 +1,225 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockHistoryProvider struct {
+	mock.Mock
+}
+
+func (m *MockHistoryProvider) FetchHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error) {
+	args := m.Called(ctx, query, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.History), args.Error(1)
+}
+
+type MockHistoryCache struct {
+	mock.Mock
+}
+
+func (m *MockHistoryCache) Get(ctx context.Context, key string) (*weather.History, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.History), args.Error(1)
+}
+
+func (m *MockHistoryCache) Set(ctx context.Context, key string, data *weather.History, ttl time.Duration) error {
+	args := m.Called(ctx, key, data, ttl)
+	return args.Error(0)
+}
+
+func createSampleHistory(locationName string, dates ...time.Time) *weather.History {
+	history := &weather.History{
+		Location: weather.Location{
+			Name:    locationName,
+			Country: "Greece",
+		},
+		UpdatedAt: time.Now(),
+	}
+	for _, date := range dates {
+		history.Days = append(history.Days, weather.DailyWeather{
+			Date:    date,
+			MaxTemp: weather.TemperatureValue{Celsius: 17.0},
+			MinTemp: weather.TemperatureValue{Celsius: 8.0},
+		})
+	}
+	return history
+}
+
+func TestGetHistory_CacheHit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	date := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	expected := createSampleHistory("Athens", date)
+
+	provider := new(MockHistoryProvider)
+	cache := new(MockHistoryCache)
+
+	cache.On("Get", ctx, "history:athens:2026-03-02:2026-03-02").Return(expected, nil)
+
+	service := NewHistoryService(provider, cache)
+
+	// Act
+	result, err := service.GetHistory(ctx, nameQuery("Athens"), date, date)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	provider.AssertNotCalled(t, "FetchHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	cache.AssertExpectations(t)
+}
+
+func TestGetHistory_PastDates_LongTTL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	expected := createSampleHistory("Athens", from, from.AddDate(0, 0, 1), to)
+
+	provider := new(MockHistoryProvider)
+	cache := new(MockHistoryCache)
+
+	cache.On("Get", ctx, "history:athens:2026-03-02:2026-03-04").Return(nil, nil)
+	provider.On("FetchHistory", ctx, nameQuery("Athens"), from, to).Return(expected, nil)
+	cache.On("Set", ctx, "history:athens:2026-03-02:2026-03-04", expected, fixedTTLPolicy().History).Return(nil)
+
+	service := NewHistoryService(provider, cache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	result, err := service.GetHistory(ctx, nameQuery("Athens"), from, to)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Days, 3)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetHistory_IncludesToday_ShortTTL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expected := createSampleHistory("Athens", today)
+
+	provider := new(MockHistoryProvider)
+	cache := new(MockHistoryCache)
+
+	key := historyCacheKey(nameQuery("Athens"), today, today)
+	cache.On("Get", ctx, key).Return(nil, nil)
+	provider.On("FetchHistory", ctx, nameQuery("Athens"), today, today).Return(expected, nil)
+	cache.On("Set", ctx, key, expected, fixedTTLPolicy().RecentHistory).Return(nil)
+
+	service := NewHistoryService(provider, cache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	_, err := service.GetHistory(ctx, nameQuery("Athens"), today, today)
+
+	// Assert
+	assert.NoError(t, err)
+	cache.AssertExpectations(t)
+}
+
+func TestGetHistory_ProviderError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	date := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	provider := new(MockHistoryProvider)
+	cache := new(MockHistoryCache)
+
+	cache.On("Get", ctx, mock.Anything).Return(nil, errors.New("cache miss"))
+	provider.On("FetchHistory", ctx, nameQuery("Athens"), date, date).Return(nil, errors.New("api down"))
+
+	service := NewHistoryService(provider, cache)
+
+	// Act
+	result, err := service.GetHistory(ctx, nameQuery("Athens"), date, date)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetHistory_InvalidInput(t *testing.T) {
+	future := time.Now().UTC().AddDate(0, 0, 7)
+
+	tests := []struct {
+		name        string
+		location    string
+		from        time.Time
+		to          time.Time
+		expectedErr error
+	}{
+		{
+			name:        "Empty location",
+			location:    "",
+			from:        time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			expectedErr: weather.ErrInvalidLocation,
+		},
+		{
+			name:        "End before start",
+			location:    "Athens",
+			from:        time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			expectedErr: weather.ErrInvalidDateRange,
+		},
+		{
+			name:        "Future date",
+			location:    "Athens",
+			from:        future,
+			to:          future,
+			expectedErr: weather.ErrInvalidDateRange,
+		},
+		{
+			name:        "Range too long",
+			location:    "Athens",
+			from:        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectedErr: weather.ErrInvalidDateRange,
+		},
+		{
+			name:        "Before earliest available date",
+			location:    "Athens",
+			from:        time.Date(2009, 12, 31, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2009, 12, 31, 0, 0, 0, 0, time.UTC),
+			expectedErr: weather.ErrInvalidDateRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := new(MockHistoryProvider)
+			cache := new(MockHistoryCache)
+
+			service := NewHistoryService(provider, cache)
+
+			result, err := service.GetHistory(context.Background(), nameQuery(tt.location), tt.from, tt.to)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			// Neither cache nor provider should be called for invalid input
+			cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+			provider.AssertNotCalled(t, "FetchHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
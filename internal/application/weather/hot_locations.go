@@ -0,0 +1,87 @@
+package weather
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// maxHotLocations bounds the number of locations whose requests are counted
+// Once reached, new locations are only counted after others have decayed away.
+const maxHotLocations = 10000
+
+// hotLocations counts current weather requests per location, to find the popular ones
+// Counts are halved on every decay, so popularity follows recent traffic.
+type hotLocations struct {
+	mu     sync.Mutex
+	counts map[string]*hotLocation // keyed by current weather cache key
+}
+
+// hotLocation is a location and its decayed request count
+type hotLocation struct {
+	key   string
+	query weather.LocationQuery
+	count int
+}
+
+// newHotLocations creates an empty request counter
+func newHotLocations() *hotLocations {
+	return &hotLocations{
+		counts: make(map[string]*hotLocation),
+	}
+}
+
+// record counts a request for the location cached under key
+// IP queries are not counted: each one is a single client's own location.
+func (h *hotLocations) record(key string, query weather.LocationQuery) {
+	if query.Kind == weather.LocationByIP {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if loc, ok := h.counts[key]; ok {
+		loc.count++
+		return
+	}
+	if len(h.counts) < maxHotLocations {
+		h.counts[key] = &hotLocation{key: key, query: query, count: 1}
+	}
+}
+
+// top returns up to n locations, most requested first
+func (h *hotLocations) top(n int) []weather.LocationQuery {
+	h.mu.Lock()
+	locations := make([]hotLocation, 0, len(h.counts))
+	for _, loc := range h.counts {
+		locations = append(locations, *loc)
+	}
+	h.mu.Unlock()
+
+	slices.SortFunc(locations, func(a, b hotLocation) int {
+		// Ties are broken by key so the order is stable between cycles
+		return cmp.Or(cmp.Compare(b.count, a.count), cmp.Compare(a.key, b.key))
+	})
+
+	queries := make([]weather.LocationQuery, 0, min(n, len(locations)))
+	for _, loc := range locations[:min(n, len(locations))] {
+		queries = append(queries, loc.query)
+	}
+	return queries
+}
+
+// decay halves every count and forgets the locations that are no longer requested
+func (h *hotLocations) decay() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key, loc := range h.counts {
+		loc.count /= 2
+		if loc.count == 0 {
+			delete(h.counts, key)
+		}
+	}
+}
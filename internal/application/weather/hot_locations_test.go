@@ -0,0 +1,55 @@
+package weather
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestHotLocations_TopOrdersByRequests(t *testing.T) {
+	// Arrange
+	hot := newHotLocations()
+	for range 3 {
+		hot.record("current:paris", nameQuery("Paris"))
+	}
+	hot.record("current:athens", nameQuery("Athens"))
+	for range 2 {
+		hot.record("current:london", nameQuery("London"))
+	}
+	hot.record("current:berlin", nameQuery("Berlin"))
+
+	// Act
+	top := hot.top(3)
+
+	// Assert
+	assert.Equal(t, []weather.LocationQuery{nameQuery("Paris"), nameQuery("London"), nameQuery("Athens")}, top)
+}
+
+func TestHotLocations_IgnoresIPQueries(t *testing.T) {
+	// Arrange
+	hot := newHotLocations()
+	hot.record("current:ip:203.0.113.7", weather.LocationQuery{Kind: weather.LocationByIP, IP: "203.0.113.7"})
+
+	// Act
+	top := hot.top(10)
+
+	// Assert
+	assert.Empty(t, top)
+}
+
+func TestHotLocations_DecayForgetsQuietLocations(t *testing.T) {
+	// Arrange
+	hot := newHotLocations()
+	for range 4 {
+		hot.record("current:paris", nameQuery("Paris"))
+	}
+	hot.record("current:athens", nameQuery("Athens"))
+
+	// Act
+	hot.decay()
+
+	// Assert
+	assert.Equal(t, []weather.LocationQuery{nameQuery("Paris")}, hot.top(10))
+}
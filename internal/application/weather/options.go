@@ -0,0 +1,72 @@
+package weather
+
+import "time"
+
+// Default lifetimes of stale current weather
+// Past its freshness lifetime, cached weather is served stale while it is
+// refreshed in the background for the revalidation window, and served stale
+// when the provider fails until the hard TTL, when it expires from cache.
+const (
+	defaultStaleWhileRevalidate = time.Hour
+	defaultHardTTL              = 24 * time.Hour
+)
+
+// Option configures an application service
+type Option func(*options)
+
+// options holds the settings shared by the application services
+type options struct {
+	ttlPolicy            TTLPolicy
+	staleWhileRevalidate time.Duration
+	hardTTL              time.Duration
+	stats                *Stats
+}
+
+// WithTTLPolicy sets how long each type of data stays in cache
+func WithTTLPolicy(policy TTLPolicy) Option {
+	return func(o *options) {
+		o.ttlPolicy = policy
+	}
+}
+
+// WithStaleWhileRevalidate sets how long past its freshness lifetime cached
+// weather is served stale while it is refreshed in the background
+func WithStaleWhileRevalidate(window time.Duration) Option {
+	return func(o *options) {
+		o.staleWhileRevalidate = window
+	}
+}
+
+// WithHardTTL sets how long cached weather is kept, and so how long it can be
+// served stale when the provider fails
+// It is raised to the end of the revalidation window if shorter.
+func WithHardTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.hardTTL = ttl
+	}
+}
+
+// WithStats sets the counters the service records its cache and upstream activity in
+// Services given the same Stats report together.
+func WithStats(stats *Stats) Option {
+	return func(o *options) {
+		o.stats = stats
+	}
+}
+
+// newOptions applies opts over the defaults
+func newOptions(opts []Option) options {
+	o := options{
+		ttlPolicy:            DefaultTTLPolicy(),
+		staleWhileRevalidate: defaultStaleWhileRevalidate,
+		hardTTL:              defaultHardTTL,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.stats == nil {
+		o.stats = NewStats()
+	}
+	o.hardTTL = max(o.hardTTL, o.ttlPolicy.Current+o.staleWhileRevalidate)
+	return o
+}
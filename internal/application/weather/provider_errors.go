@@ -0,0 +1,26 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// providerError translates an error from a provider port into the error returned to callers
+// Errors about the request itself, such as an unknown location, are returned
+// as their domain error; anything else means the provider is unavailable.
+// Quota and credential problems keep their own domain error alongside
+// ErrWeatherUnavailable, so they are reported as such rather than as an outage.
+func providerError(err error) error {
+	switch {
+	case errors.Is(err, weather.ErrWeatherNotFound):
+		return weather.ErrWeatherNotFound
+	case errors.Is(err, weather.ErrInvalidLocation):
+		return weather.ErrInvalidLocation
+	case errors.Is(err, weather.ErrProviderMisconfigured):
+		log.Printf("Error: weather provider rejected the configured credentials: %v", err)
+	}
+	return fmt.Errorf("%w: %w", weather.ErrWeatherUnavailable, err)
+}
@@ -0,0 +1,133 @@
+package weather
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// RefreshPolicy controls which cached current weather the refresher keeps fresh, and how often
+type RefreshPolicy struct {
+	// Interval is the time between refresh cycles; Run requires it to be positive
+	Interval time.Duration
+	// TopLocations is the number of most requested locations refreshed each cycle
+	TopLocations int
+	// Lead is how long before the end of its freshness lifetime an entry is refreshed
+	Lead time.Duration
+	// Budget is the maximum number of upstream calls per minute, shared by refreshes and warm-ups
+	Budget int
+	// Pinned locations are refreshed ahead of the popular ones, whatever their traffic
+	Pinned []weather.LocationQuery
+}
+
+// Refresher keeps the current weather of popular locations fresh in cache,
+// so that their requests are not the ones paying for upstream calls
+// It implements the WarmUpCacheUseCase use case.
+type Refresher struct {
+	service *Service
+	policy  RefreshPolicy
+	budget  *rate.Limiter
+}
+
+// NewRefresher creates a refresher for the locations requested through service
+func NewRefresher(service *Service, policy RefreshPolicy) *Refresher {
+	budget := max(policy.Budget, 1)
+	return &Refresher{
+		service: service,
+		policy:  policy,
+		budget:  rate.NewLimiter(rate.Limit(float64(budget)/60), budget),
+	}
+}
+
+// Run refreshes entries every interval until ctx is cancelled
+// The first cycle runs immediately, warming the cache with the pinned locations.
+func (r *Refresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.policy.Interval)
+	defer ticker.Stop()
+
+	for {
+		if refreshed := r.RefreshDue(ctx); refreshed > 0 {
+			log.Printf("Refreshed cached weather for %d locations", refreshed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshDue refreshes the pinned and most requested locations whose entries are
+// missing or about to go stale, and returns how many were refreshed
+// Locations are refreshed in priority order; once the upstream budget is spent,
+// the rest wait for the next cycle.
+func (r *Refresher) RefreshDue(ctx context.Context) int {
+	candidates := slices.Concat(r.policy.Pinned, r.service.hot.top(r.policy.TopLocations))
+	r.service.hot.decay()
+
+	refreshed := 0
+	seen := make(map[string]bool, len(candidates))
+	for _, query := range candidates {
+		key := currentCacheKey(query)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if !r.due(ctx, key) {
+			continue
+		}
+
+		if !r.budget.Allow() {
+			log.Printf("Upstream budget spent, deferring refreshes from location: %s", key)
+			break
+		}
+		if _, err := r.service.fetch(ctx, query, key); err != nil {
+			log.Printf("Warning: failed to refresh weather data for %s: %v", key, err)
+			continue
+		}
+		refreshed++
+	}
+	return refreshed
+}
+
+// WarmUp fetches and caches the current weather of each location, waiting for
+// upstream budget as needed, and returns how many were cached
+// Invalid locations and provider failures are logged and skipped; an error is
+// only returned if ctx is cancelled.
+func (r *Refresher) WarmUp(ctx context.Context, queries []weather.LocationQuery) (int, error) {
+	warmed := 0
+	for _, query := range queries {
+		if err := query.Validate(); err != nil {
+			log.Printf("Warning: skipping warm-up of %s: %v", query, err)
+			continue
+		}
+		if err := r.budget.Wait(ctx); err != nil {
+			return warmed, err
+		}
+
+		key := currentCacheKey(query)
+		if _, err := r.service.fetch(ctx, query, key); err != nil {
+			log.Printf("Warning: failed to warm up weather data for %s: %v", key, err)
+			continue
+		}
+		warmed++
+	}
+	return warmed, nil
+}
+
+// due reports whether the entry under key is missing or leaves its freshness lifetime within the lead time
+// Entries that cannot be read are not refreshed, since the result could not be cached either.
+// The entry is peeked at, so probing neither warms the cache nor skews its statistics.
+func (r *Refresher) due(ctx context.Context, key string) bool {
+	cached, err := r.service.cache.Peek(ctx, key)
+	if err != nil {
+		return false
+	}
+	return cached == nil || !time.Now().Before(cached.FreshUntil.Add(-r.policy.Lead))
+}
@@ -0,0 +1,167 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestRefreshDue_RefreshesMissingAndExpiringEntries(t *testing.T) {
+	// Arrange
+	mockProvider := new(MockWeatherProvider)
+	mockCache := new(MockWeatherCache)
+	fresh := createSampleWeather("Paris", 18)
+	expiring := createSampleWeather("London", 15)
+	expiring.FreshUntil = time.Now().Add(30 * time.Second)
+
+	mockCache.On("Peek", mock.Anything, "current:athens").Return(nil, nil)
+	mockCache.On("Peek", mock.Anything, "current:london").Return(expiring, nil)
+	mockCache.On("Peek", mock.Anything, "current:paris").Return(fresh, nil)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockProvider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(createSampleWeather("Athens", 25), nil).Once()
+	mockProvider.On("FetchWeather", mock.Anything, nameQuery("London")).Return(createSampleWeather("London", 15), nil).Once()
+
+	service := NewService(mockProvider, mockCache, WithTTLPolicy(fixedTTLPolicy()))
+	service.hot.record("current:london", nameQuery("London"))
+	service.hot.record("current:paris", nameQuery("Paris"))
+
+	refresher := NewRefresher(service, RefreshPolicy{
+		TopLocations: 10,
+		Lead:         time.Minute,
+		Budget:       60,
+		Pinned:       []weather.LocationQuery{nameQuery("Athens")},
+	})
+
+	// Act
+	refreshed := refresher.RefreshDue(context.Background())
+
+	// Assert
+	assert.Equal(t, 2, refreshed)
+	mockProvider.AssertExpectations(t)
+	mockProvider.AssertNotCalled(t, "FetchWeather", mock.Anything, nameQuery("Paris"))
+}
+
+func TestRefreshDue_LeavesCacheStatisticsUnchanged(t *testing.T) {
+	// Arrange
+	mockProvider := new(MockWeatherProvider)
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Peek", mock.Anything, "current:paris").Return(createSampleWeather("Paris", 18), nil)
+	mockCache.On("Peek", mock.Anything, "current:athens").Return(nil, nil)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockProvider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(createSampleWeather("Athens", 25), nil)
+
+	stats := NewStats()
+	service := NewService(mockProvider, mockCache, WithTTLPolicy(fixedTTLPolicy()), WithStats(stats))
+	refresher := NewRefresher(service, RefreshPolicy{
+		Lead:   time.Minute,
+		Budget: 60,
+		Pinned: []weather.LocationQuery{nameQuery("Paris"), nameQuery("Athens")},
+	})
+
+	// Act
+	refresher.RefreshDue(context.Background())
+
+	// Assert
+	snapshot, err := stats.GetStats(context.Background())
+	require.NoError(t, err)
+	counters := snapshot.ByDataType[string(DataCurrent)]
+	assert.Zero(t, counters.Hits)
+	assert.Zero(t, counters.StaleServes)
+	assert.Zero(t, counters.Misses)
+	mockCache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestRefreshDue_StopsWhenBudgetIsSpent(t *testing.T) {
+	// Arrange
+	mockProvider := new(MockWeatherProvider)
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Peek", mock.Anything, mock.Anything).Return(nil, nil)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockProvider.On("FetchWeather", mock.Anything, mock.Anything).Return(createSampleWeather("Athens", 25), nil)
+
+	service := NewService(mockProvider, mockCache)
+	refresher := NewRefresher(service, RefreshPolicy{
+		Budget: 1,
+		Pinned: []weather.LocationQuery{nameQuery("Athens"), nameQuery("London")},
+	})
+
+	// Act
+	refreshed := refresher.RefreshDue(context.Background())
+
+	// Assert
+	assert.Equal(t, 1, refreshed)
+	mockProvider.AssertNumberOfCalls(t, "FetchWeather", 1)
+	mockProvider.AssertCalled(t, "FetchWeather", mock.Anything, nameQuery("Athens"))
+}
+
+func TestRefreshDue_SkipsUnreadableCache(t *testing.T) {
+	// Arrange
+	mockProvider := new(MockWeatherProvider)
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Peek", mock.Anything, "current:athens").Return(nil, errors.New("connection refused"))
+
+	service := NewService(mockProvider, mockCache)
+	refresher := NewRefresher(service, RefreshPolicy{
+		Budget: 60,
+		Pinned: []weather.LocationQuery{nameQuery("Athens")},
+	})
+
+	// Act
+	refreshed := refresher.RefreshDue(context.Background())
+
+	// Assert
+	assert.Zero(t, refreshed)
+	mockProvider.AssertNotCalled(t, "FetchWeather", mock.Anything, mock.Anything)
+}
+
+func TestWarmUp(t *testing.T) {
+	// Arrange
+	mockProvider := new(MockWeatherProvider)
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockProvider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(createSampleWeather("Athens", 25), nil)
+	mockProvider.On("FetchWeather", mock.Anything, nameQuery("Atlantis")).Return(nil, errors.New("no matching location"))
+
+	service := NewService(mockProvider, mockCache)
+	refresher := NewRefresher(service, RefreshPolicy{Budget: 60})
+
+	// Act
+	warmed, err := refresher.WarmUp(context.Background(), []weather.LocationQuery{
+		nameQuery("Athens"),
+		nameQuery(""),
+		nameQuery("Atlantis"),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, warmed)
+	mockProvider.AssertNumberOfCalls(t, "FetchWeather", 2)
+}
+
+func TestWarmUp_CancelledWhileWaitingForBudget(t *testing.T) {
+	// Arrange
+	mockProvider := new(MockWeatherProvider)
+	mockCache := new(MockWeatherCache)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockProvider.On("FetchWeather", mock.Anything, mock.Anything).Return(createSampleWeather("Athens", 25), nil)
+
+	service := NewService(mockProvider, mockCache)
+	refresher := NewRefresher(service, RefreshPolicy{Budget: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Act
+	warmed, err := refresher.WarmUp(ctx, []weather.LocationQuery{nameQuery("Athens"), nameQuery("London")})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, 1, warmed)
+}
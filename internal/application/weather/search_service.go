@@ -0,0 +1,75 @@
+package weather
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+	"weather-api-wrapper/internal/ports/output"
+)
+
+// SearchService implements the SearchLocationsUseCase use case
+// It orchestrates location search using a cache-aside pattern
+type SearchService struct {
+	searchProvider output.LocationSearchProvider
+	cache          output.LocationSearchCache
+	options
+}
+
+// NewSearchService creates a new location search application service
+func NewSearchService(provider output.LocationSearchProvider, cache output.LocationSearchCache, opts ...Option) *SearchService {
+	return &SearchService{
+		searchProvider: provider,
+		cache:          cache,
+		options:        newOptions(opts),
+	}
+}
+
+// SearchLocations retrieves the candidate locations matching a partial name
+// It follows the same cache-aside flow as Service.GetWeather
+func (s *SearchService) SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error) {
+	// Domain validation
+	query, err := weather.NormalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	key := searchCacheKey(query)
+
+	// Try to get from cache first
+	cachedSearch, err := s.cache.Get(ctx, key)
+	if err == nil && cachedSearch != nil {
+		log.Printf("Cache hit for location search: %s", key)
+		s.stats.hit(DataSearch)
+		return cachedSearch, nil
+	}
+
+	// Cache miss - fetch from search provider
+	log.Printf("Cache miss for location search: %s", key)
+	s.stats.miss(DataSearch)
+	// Partial names are not locations, so searches are only counted per data type
+	s.stats.upstreamCall(DataSearch, "")
+	search, err := s.searchProvider.SearchLocations(ctx, query)
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	// Record the normalized query and update the timestamp
+	search.Query = query
+	search.UpdatedAt = time.Now()
+
+	// Store in cache (non-blocking - don't fail the request if caching fails)
+	if err := s.cache.Set(ctx, key, search, s.ttlPolicy.TTL(DataSearch, time.Time{}, search.UpdatedAt)); err != nil {
+		log.Printf("Warning: failed to cache location search for %s: %v", key, err)
+		s.stats.setFailure(DataSearch)
+	}
+
+	return search, nil
+}
+
+// searchCacheKey builds the cache key for a location search
+// Searches are case-insensitive, so differently cased queries share an entry
+func searchCacheKey(query string) string {
+	return "search:" + canonicalText(query)
+}
@@ -0,0 +1,142 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+type MockLocationSearchProvider struct {
+	mock.Mock
+}
+
+func (m *MockLocationSearchProvider) SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.LocationSearch), args.Error(1)
+}
+
+type MockLocationSearchCache struct {
+	mock.Mock
+}
+
+func (m *MockLocationSearchCache) Get(ctx context.Context, key string) (*weather.LocationSearch, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.LocationSearch), args.Error(1)
+}
+
+func (m *MockLocationSearchCache) Set(ctx context.Context, key string, data *weather.LocationSearch, ttl time.Duration) error {
+	args := m.Called(ctx, key, data, ttl)
+	return args.Error(0)
+}
+
+func createSampleSearch(query string) *weather.LocationSearch {
+	return &weather.LocationSearch{
+		Query: query,
+		Candidates: []weather.LocationCandidate{
+			{ID: 2640463, Name: "Springfield", Region: "Illinois", Country: "United States of America", Latitude: 39.8, Longitude: -89.64},
+			{ID: 2640489, Name: "Springfield", Region: "Missouri", Country: "United States of America", Latitude: 37.22, Longitude: -93.3},
+		},
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestSearchLocations_CacheHit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleSearch("Springfield")
+
+	provider := new(MockLocationSearchProvider)
+	cache := new(MockLocationSearchCache)
+
+	cache.On("Get", ctx, "search:springfield").Return(expected, nil)
+
+	service := NewSearchService(provider, cache)
+
+	// Act
+	result, err := service.SearchLocations(ctx, "SPRINGFIELD")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	provider.AssertNotCalled(t, "SearchLocations", mock.Anything, mock.Anything)
+	cache.AssertExpectations(t)
+}
+
+func TestSearchLocations_CacheMiss_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleSearch("Springf")
+
+	provider := new(MockLocationSearchProvider)
+	cache := new(MockLocationSearchCache)
+
+	cache.On("Get", ctx, "search:springf").Return(nil, nil)
+	provider.On("SearchLocations", ctx, "Springf").Return(expected, nil)
+	cache.On("Set", ctx, "search:springf", expected, fixedTTLPolicy().Search).Return(nil)
+
+	service := NewSearchService(provider, cache, WithTTLPolicy(fixedTTLPolicy()))
+
+	// Act
+	result, err := service.SearchLocations(ctx, "  Springf ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Springf", result.Query)
+	assert.Len(t, result.Candidates, 2)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestSearchLocations_ProviderError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	provider := new(MockLocationSearchProvider)
+	cache := new(MockLocationSearchCache)
+
+	cache.On("Get", ctx, "search:springfield").Return(nil, errors.New("cache miss"))
+	provider.On("SearchLocations", ctx, "Springfield").Return(nil, errors.New("api down"))
+
+	service := NewSearchService(provider, cache)
+
+	// Act
+	result, err := service.SearchLocations(ctx, "Springfield")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSearchLocations_QueryTooShort(t *testing.T) {
+	// Arrange
+	provider := new(MockLocationSearchProvider)
+	cache := new(MockLocationSearchCache)
+
+	service := NewSearchService(provider, cache)
+
+	// Act
+	result, err := service.SearchLocations(context.Background(), " S ")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrInvalidSearchQuery)
+
+	cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	provider.AssertNotCalled(t, "SearchLocations", mock.Anything, mock.Anything)
+}
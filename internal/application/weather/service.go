@@ -6,24 +6,33 @@ import (
 	"log"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	"weather-api-wrapper/internal/domain/weather"
 	"weather-api-wrapper/internal/ports/output"
 )
 
-const defaultCacheTTL = 12 * time.Hour
+// sharedFetchTimeout bounds an upstream fetch shared by coalesced requests,
+// since it is not cancelled when any one of them gives up
+const sharedFetchTimeout = 30 * time.Second
 
 // Service implements the GetWeatherUseCase use case
 // It orchestrates weather data retrieval using a cache-aside pattern
 type Service struct {
 	weatherProvider output.WeatherProvider
 	cache           output.WeatherCache
+	flights         singleflight.Group
+	hot             *hotLocations
+	options
 }
 
 // NewService creates a new weather application service
-func NewService(provider output.WeatherProvider, cache output.WeatherCache) *Service {
+func NewService(provider output.WeatherProvider, cache output.WeatherCache, opts ...Option) *Service {
 	return &Service{
 		weatherProvider: provider,
 		cache:           cache,
+		hot:             newHotLocations(),
+		options:         newOptions(opts),
 	}
 }
 
@@ -33,33 +42,128 @@ func NewService(provider output.WeatherProvider, cache output.WeatherCache) *Ser
 // 2. On cache miss, fetch from weather provider
 // 3. Update cache with fresh data
 // 4. Return weather data
-func (s *Service) GetWeather(ctx context.Context, location string) (*weather.Weather, error) {
+// Fresh data is cached under the location the provider resolved the query to,
+// and the query's own key is aliased to it, so equivalent queries share an entry.
+// Cached data past its freshness lifetime is returned flagged as stale, either while it is
+// refreshed in the background or when the provider fails, up to the hard TTL.
+func (s *Service) GetWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error) {
 	// Domain validation
-	if err := weather.ValidateLocation(location); err != nil {
+	if err := query.Validate(); err != nil {
 		return nil, err
 	}
+	key := currentCacheKey(query)
+	s.hot.record(key, query)
 
 	// Try to get from cache first
-	cachedWeather, err := s.cache.Get(ctx, location)
-	if err == nil && cachedWeather != nil {
-		log.Printf("Cache hit for location: %s", location)
-		return cachedWeather, nil
+	cachedWeather, err := s.cache.Get(ctx, key)
+	hasCached := err == nil && cachedWeather != nil
+	if hasCached {
+		now := time.Now()
+		switch {
+		case now.Before(cachedWeather.FreshUntil):
+			log.Printf("Cache hit for location: %s", key)
+			s.stats.hit(DataCurrent)
+			return cachedWeather, nil
+		case now.Before(cachedWeather.FreshUntil.Add(s.staleWhileRevalidate)):
+			log.Printf("Stale cache hit for location: %s, revalidating", key)
+			s.revalidate(ctx, query, key)
+			s.stats.staleServe(DataCurrent)
+			return markStale(cachedWeather), nil
+		}
+		log.Printf("Cache entry past revalidation window for location: %s", key)
+	} else {
+		// Cache miss - fetch from weather provider
+		log.Printf("Cache miss for location: %s", key)
+	}
+
+	weatherData, err := s.fetch(ctx, query, key)
+	if err != nil {
+		// Stale data beats no data while the provider is failing
+		if hasCached && cachedWeather.Age(time.Now()) < s.hardTTL {
+			log.Printf("Serving stale weather for %s after provider failure: %v", key, err)
+			s.stats.staleServe(DataCurrent)
+			return markStale(cachedWeather), nil
+		}
+		s.stats.miss(DataCurrent)
+		return nil, err
+	}
+
+	s.stats.miss(DataCurrent)
+	return weatherData, nil
+}
+
+// revalidate refreshes a cache entry in the background
+// It shares the in-flight fetch, if any, so stale hits trigger one refresh per location.
+func (s *Service) revalidate(ctx context.Context, query weather.LocationQuery, key string) {
+	go func() {
+		if _, err := s.fetch(context.WithoutCancel(ctx), query, key); err != nil {
+			log.Printf("Warning: failed to revalidate weather data for %s: %v", key, err)
+		}
+	}()
+}
+
+// markStale returns a copy of cached weather flagged as stale, leaving the cached value unmodified
+func markStale(cached *weather.Weather) *weather.Weather {
+	stale := *cached
+	stale.Stale = true
+	return &stale
+}
+
+// fetch retrieves weather data from the provider and caches it
+// Concurrent misses for the same cache key share a single upstream call and
+// all get its result or error. The shared call outlives callers that give up,
+// so one cancelled request doesn't fail the others.
+func (s *Service) fetch(ctx context.Context, query weather.LocationQuery, key string) (*weather.Weather, error) {
+	results := s.flights.DoChan(key, func() (any, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedFetchTimeout)
+		defer cancel()
+		return s.fetchAndCache(fetchCtx, query, key)
+	})
+
+	select {
+	case result := <-results:
+		if result.Shared {
+			log.Printf("Shared upstream fetch for location: %s", key)
+		}
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*weather.Weather), nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: %v", weather.ErrWeatherUnavailable, ctx.Err())
 	}
+}
 
-	// Cache miss - fetch from weather provider
-	log.Printf("Cache miss for location: %s", location)
-	weatherData, err := s.weatherProvider.FetchWeather(ctx, location)
+// fetchAndCache calls the weather provider and stores the result in cache
+func (s *Service) fetchAndCache(ctx context.Context, query weather.LocationQuery, key string) (*weather.Weather, error) {
+	s.stats.upstreamCall(DataCurrent, locationBucket(query))
+	weatherData, err := s.weatherProvider.FetchWeather(ctx, query)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", weather.ErrWeatherUnavailable, err)
+		return nil, providerError(err)
 	}
 
-	// Update the timestamp
+	// Update the timestamp and decide how long the data stays fresh
 	weatherData.UpdatedAt = time.Now()
+	weatherData.FreshUntil = weatherData.UpdatedAt.Add(s.ttlPolicy.TTL(DataCurrent, weatherData.Current.LastUpdated, weatherData.UpdatedAt))
+
+	// IP addresses get reassigned, so they are never aliased to a location
+	entryKey := key
+	if resolved, ok := resolvedCacheKey(weatherData.Location); ok && query.Kind != weather.LocationByIP {
+		entryKey = resolved
+	}
 
 	// Store in cache (non-blocking - don't fail the request if caching fails)
-	if err := s.cache.Set(ctx, location, weatherData, defaultCacheTTL); err != nil {
-		log.Printf("Warning: failed to cache weather data for %s: %v", location, err)
+	if err := s.cache.Set(ctx, entryKey, weatherData, s.hardTTL); err != nil {
+		log.Printf("Warning: failed to cache weather data for %s: %v", entryKey, err)
+		s.stats.setFailure(DataCurrent)
 		// Continue - caching failure shouldn't break the request
+		return weatherData, nil
+	}
+
+	if entryKey != key {
+		if err := s.cache.Alias(ctx, key, entryKey, aliasCacheTTL); err != nil {
+			log.Printf("Warning: failed to alias %s to %s: %v", key, entryKey, err)
+		}
 	}
 
 	return weatherData, nil
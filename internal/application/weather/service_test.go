@@ -3,23 +3,31 @@ package weather
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"weather-api-wrapper/internal/domain/weather"
 )
 
+// nameQuery builds a location query for a place name
+func nameQuery(name string) weather.LocationQuery {
+	return weather.LocationQuery{Kind: weather.LocationByName, Name: name}
+}
+
 // Mock implementations for testing
 
 type MockWeatherProvider struct {
 	mock.Mock
 }
 
-func (m *MockWeatherProvider) FetchWeather(ctx context.Context, location string) (*weather.Weather, error) {
-	args := m.Called(ctx, location)
+func (m *MockWeatherProvider) FetchWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error) {
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -30,19 +38,66 @@ type MockWeatherCache struct {
 	mock.Mock
 }
 
-func (m *MockWeatherCache) Get(ctx context.Context, location string) (*weather.Weather, error) {
-	args := m.Called(ctx, location)
+func (m *MockWeatherCache) Get(ctx context.Context, key string) (*weather.Weather, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.Weather), args.Error(1)
+}
+
+func (m *MockWeatherCache) Peek(ctx context.Context, key string) (*weather.Weather, error) {
+	args := m.Called(ctx, key)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*weather.Weather), args.Error(1)
 }
 
-func (m *MockWeatherCache) Set(ctx context.Context, location string, data *weather.Weather, ttl time.Duration) error {
-	args := m.Called(ctx, location, data, ttl)
+func (m *MockWeatherCache) Set(ctx context.Context, key string, data *weather.Weather, ttl time.Duration) error {
+	args := m.Called(ctx, key, data, ttl)
 	return args.Error(0)
 }
 
+func (m *MockWeatherCache) Alias(ctx context.Context, alias, key string, ttl time.Duration) error {
+	args := m.Called(ctx, alias, key, ttl)
+	return args.Error(0)
+}
+
+func (m *MockWeatherCache) List(ctx context.Context, prefix string) ([]weather.CachedWeather, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]weather.CachedWeather), args.Error(1)
+}
+
+func (m *MockWeatherCache) Namespace() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockWeatherCache) Keys(ctx context.Context, prefix string) ([]string, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockWeatherCache) Inspect(ctx context.Context, key string) (*weather.CacheEntry, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*weather.CacheEntry), args.Error(1)
+}
+
+func (m *MockWeatherCache) Delete(ctx context.Context, keys ...string) (int, error) {
+	args := m.Called(ctx, keys)
+	return args.Int(0), args.Error(1)
+}
+
 // Helper function to create sample weather data
 func createSampleWeather(locationName string, tempC float64) *weather.Weather {
 	return &weather.Weather{
@@ -60,7 +115,8 @@ func createSampleWeather(locationName string, tempC float64) *weather.Weather {
 				Code: 1000,
 			},
 		},
-		UpdatedAt: time.Now(),
+		UpdatedAt:  time.Now(),
+		FreshUntil: time.Now().Add(time.Hour),
 	}
 }
 
@@ -75,12 +131,12 @@ func TestGetWeather_CacheHit(t *testing.T) {
 	provider := new(MockWeatherProvider)
 	cache := new(MockWeatherCache)
 
-	cache.On("Get", ctx, location).Return(expected, nil)
+	cache.On("Get", ctx, "current:athens").Return(expected, nil)
 
 	service := NewService(provider, cache)
 
 	// Act
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	assert.NoError(t, err)
@@ -102,14 +158,14 @@ func TestGetWeather_CacheMiss_Success(t *testing.T) {
 	provider := new(MockWeatherProvider)
 	cache := new(MockWeatherCache)
 
-	cache.On("Get", ctx, location).Return(nil, errors.New("cache miss"))
-	provider.On("FetchWeather", ctx, location).Return(expected, nil)
-	cache.On("Set", ctx, location, mock.AnythingOfType("*weather.Weather"), defaultCacheTTL).Return(nil)
+	cache.On("Get", ctx, "current:athens").Return(nil, errors.New("cache miss"))
+	provider.On("FetchWeather", mock.Anything, nameQuery(location)).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:athens", mock.AnythingOfType("*weather.Weather"), defaultHardTTL).Return(nil)
 
 	service := NewService(provider, cache)
 
 	// Act
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	assert.NoError(t, err)
@@ -121,6 +177,53 @@ func TestGetWeather_CacheMiss_Success(t *testing.T) {
 	cache.AssertExpectations(t)
 }
 
+func TestGetWeather_CoordinatesQuery(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByCoordinates, Latitude: 37.9838, Longitude: 23.7275}
+	expected := createSampleWeather("Athens", 25.0)
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:37.98,23.73").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, query).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:37.98,23.73", mock.AnythingOfType("*weather.Weather"), defaultHardTTL).Return(nil)
+
+	service := NewService(provider, cache)
+
+	// Act
+	result, err := service.GetWeather(ctx, query)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Athens", result.Location.Name)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetWeather_InvalidCoordinatesQuery(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByCoordinates, Latitude: 95, Longitude: 23.7275}
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	service := NewService(provider, cache)
+
+	// Act
+	result, err := service.GetWeather(ctx, query)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrInvalidCoordinates)
+
+	cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	provider.AssertNotCalled(t, "FetchWeather", mock.Anything, mock.Anything)
+}
+
 func TestGetWeather_ProviderError(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
@@ -130,13 +233,13 @@ func TestGetWeather_ProviderError(t *testing.T) {
 	provider := new(MockWeatherProvider)
 	cache := new(MockWeatherCache)
 
-	cache.On("Get", ctx, location).Return(nil, errors.New("cache miss"))
-	provider.On("FetchWeather", ctx, location).Return(nil, providerError)
+	cache.On("Get", ctx, "current:athens").Return(nil, errors.New("cache miss"))
+	provider.On("FetchWeather", mock.Anything, nameQuery(location)).Return(nil, providerError)
 
 	service := NewService(provider, cache)
 
 	// Act
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	assert.Error(t, err)
@@ -147,6 +250,65 @@ func TestGetWeather_ProviderError(t *testing.T) {
 	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestGetWeather_ProviderErrorKinds(t *testing.T) {
+	tests := []struct {
+		name            string
+		providerErr     error
+		expectedErr     error
+		unavailable     bool
+		expectedMessage string
+	}{
+		{
+			name:            "Location not found",
+			providerErr:     fmt.Errorf("API returned non-OK status: %w: code 1006", weather.ErrWeatherNotFound),
+			expectedErr:     weather.ErrWeatherNotFound,
+			expectedMessage: "weather data not found",
+		},
+		{
+			name:        "Invalid location",
+			providerErr: fmt.Errorf("API returned non-OK status: %w: code 1003", weather.ErrInvalidLocation),
+			expectedErr: weather.ErrInvalidLocation,
+		},
+		{
+			name:        "Quota exceeded",
+			providerErr: fmt.Errorf("API returned non-OK status: %w: code 2007", weather.ErrQuotaExceeded),
+			expectedErr: weather.ErrQuotaExceeded,
+			unavailable: true,
+		},
+		{
+			name:        "Misconfigured",
+			providerErr: fmt.Errorf("API returned non-OK status: %w: code 2006", weather.ErrProviderMisconfigured),
+			expectedErr: weather.ErrProviderMisconfigured,
+			unavailable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			provider := new(MockWeatherProvider)
+			cache := new(MockWeatherCache)
+
+			cache.On("Get", ctx, "current:atlantis").Return(nil, nil)
+			provider.On("FetchWeather", mock.Anything, nameQuery("Atlantis")).Return(nil, tt.providerErr)
+
+			service := NewService(provider, cache)
+
+			// Act
+			result, err := service.GetWeather(ctx, nameQuery("Atlantis"))
+
+			// Assert
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.unavailable, errors.Is(err, weather.ErrWeatherUnavailable))
+			if tt.expectedMessage != "" {
+				assert.EqualError(t, err, tt.expectedMessage, "provider details are not passed on")
+			}
+		})
+	}
+}
+
 func TestGetWeather_CacheSetError_StillReturnsData(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
@@ -157,14 +319,14 @@ func TestGetWeather_CacheSetError_StillReturnsData(t *testing.T) {
 	provider := new(MockWeatherProvider)
 	cache := new(MockWeatherCache)
 
-	cache.On("Get", ctx, location).Return(nil, errors.New("cache miss"))
-	provider.On("FetchWeather", ctx, location).Return(expected, nil)
-	cache.On("Set", ctx, location, mock.AnythingOfType("*weather.Weather"), defaultCacheTTL).Return(cacheError)
+	cache.On("Get", ctx, "current:athens").Return(nil, errors.New("cache miss"))
+	provider.On("FetchWeather", mock.Anything, nameQuery(location)).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:athens", mock.AnythingOfType("*weather.Weather"), defaultHardTTL).Return(cacheError)
 
 	service := NewService(provider, cache)
 
 	// Act
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	// Cache failure should not fail the request - data should still be returned
@@ -187,7 +349,7 @@ func TestGetWeather_InvalidLocation_Empty(t *testing.T) {
 	service := NewService(provider, cache)
 
 	// Act
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	assert.Error(t, err)
@@ -210,7 +372,7 @@ func TestGetWeather_InvalidLocation_Whitespace(t *testing.T) {
 	service := NewService(provider, cache)
 
 	// Act
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	assert.Error(t, err)
@@ -233,15 +395,15 @@ func TestGetWeather_UpdatedAtTimestamp(t *testing.T) {
 	provider := new(MockWeatherProvider)
 	cache := new(MockWeatherCache)
 
-	cache.On("Get", ctx, location).Return(nil, errors.New("cache miss"))
-	provider.On("FetchWeather", ctx, location).Return(weatherData, nil)
-	cache.On("Set", ctx, location, mock.AnythingOfType("*weather.Weather"), defaultCacheTTL).Return(nil)
+	cache.On("Get", ctx, "current:athens").Return(nil, errors.New("cache miss"))
+	provider.On("FetchWeather", mock.Anything, nameQuery(location)).Return(weatherData, nil)
+	cache.On("Set", mock.Anything, "current:athens", mock.AnythingOfType("*weather.Weather"), defaultHardTTL).Return(nil)
 
 	service := NewService(provider, cache)
 
 	// Act
 	beforeCall := time.Now()
-	result, err := service.GetWeather(ctx, location)
+	result, err := service.GetWeather(ctx, nameQuery(location))
 	afterCall := time.Now()
 
 	// Assert
@@ -261,18 +423,360 @@ func TestGetWeather_TTL(t *testing.T) {
 	provider := new(MockWeatherProvider)
 	cache := new(MockWeatherCache)
 
-	cache.On("Get", ctx, location).Return(nil, errors.New("cache miss"))
-	provider.On("FetchWeather", ctx, location).Return(expected, nil)
+	cache.On("Get", ctx, "current:athens").Return(nil, errors.New("cache miss"))
+	provider.On("FetchWeather", mock.Anything, nameQuery(location)).Return(expected, nil)
 
-	// Verify that TTL is exactly 12 hours
-	cache.On("Set", ctx, location, mock.AnythingOfType("*weather.Weather"), 12*time.Hour).Return(nil)
+	// Entries are kept for the hard TTL of 24 hours, so they can be served stale
+	cache.On("Set", mock.Anything, "current:athens", mock.AnythingOfType("*weather.Weather"), 24*time.Hour).Return(nil)
 
 	service := NewService(provider, cache)
 
 	// Act
-	_, err := service.GetWeather(ctx, location)
+	_, err := service.GetWeather(ctx, nameQuery(location))
 
 	// Assert
 	assert.NoError(t, err)
 	cache.AssertExpectations(t)
 }
+
+func TestGetWeather_AliasesQueryToResolvedLocation(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleWeather("London", 15.0)
+	expected.Location.Latitude = 51.5171
+	expected.Location.Longitude = -0.1062
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:london").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery(" LONDON ")).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:51.52,-0.11", expected, defaultHardTTL).Return(nil)
+	cache.On("Alias", mock.Anything, "current:london", "current:51.52,-0.11", aliasCacheTTL).Return(nil)
+
+	service := NewService(provider, cache)
+
+	// Act
+	result, err := service.GetWeather(ctx, nameQuery(" LONDON "))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "London", result.Location.Name)
+
+	provider.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetWeather_CoordinatesQuery_NotAliasedToItself(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByCoordinates, Latitude: 51.5171, Longitude: -0.1062}
+	expected := createSampleWeather("London", 15.0)
+	expected.Location.Latitude = 51.52
+	expected.Location.Longitude = -0.11
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:51.52,-0.11").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, query).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:51.52,-0.11", expected, defaultHardTTL).Return(nil)
+
+	service := NewService(provider, cache)
+
+	// Act
+	_, err := service.GetWeather(ctx, query)
+
+	// Assert
+	require.NoError(t, err)
+	cache.AssertExpectations(t)
+	cache.AssertNotCalled(t, "Alias", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetWeather_IPQuery_NotAliased(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	query := weather.LocationQuery{Kind: weather.LocationByIP, IP: "203.0.113.7"}
+	expected := createSampleWeather("London", 15.0)
+	expected.Location.Latitude = 51.5171
+	expected.Location.Longitude = -0.1062
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:ip:203.0.113.7").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, query).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:ip:203.0.113.7", expected, defaultHardTTL).Return(nil)
+
+	service := NewService(provider, cache)
+
+	// Act
+	_, err := service.GetWeather(ctx, query)
+
+	// Assert
+	require.NoError(t, err)
+	cache.AssertExpectations(t)
+	cache.AssertNotCalled(t, "Alias", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetWeather_ConcurrentMisses_ShareOneFetch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleWeather("Athens", 25.0)
+	release := make(chan time.Time)
+	const callers = 10
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).WaitUntil(release).Return(expected, nil).Once()
+	cache.On("Set", mock.Anything, "current:athens", expected, defaultHardTTL).Return(nil).Once()
+
+	service := NewService(provider, cache)
+
+	// Act
+	var wg sync.WaitGroup
+	results := make([]*weather.Weather, callers)
+	errs := make([]error, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = service.GetWeather(ctx, nameQuery("Athens"))
+		}()
+	}
+	// Let every caller join the in-flight fetch before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Assert
+	for i := range callers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "Athens", results[i].Location.Name)
+	}
+	provider.AssertNumberOfCalls(t, "FetchWeather", 1)
+	cache.AssertNumberOfCalls(t, "Set", 1)
+}
+
+func TestGetWeather_ConcurrentMisses_ShareError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	release := make(chan time.Time)
+	const callers = 5
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).WaitUntil(release).Return(nil, errors.New("api down")).Once()
+
+	service := NewService(provider, cache)
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make([]error, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = service.GetWeather(ctx, nameQuery("Athens"))
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Assert
+	for i := range callers {
+		assert.ErrorIs(t, errs[i], weather.ErrWeatherUnavailable)
+	}
+	provider.AssertNumberOfCalls(t, "FetchWeather", 1)
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetWeather_CancelledCaller_DoesNotCancelSharedFetch(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	expected := createSampleWeather("Athens", 25.0)
+	release := make(chan time.Time)
+	stored := make(chan struct{})
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).WaitUntil(release).Return(expected, nil).Once()
+	cache.On("Set", mock.Anything, "current:athens", expected, defaultHardTTL).
+		Run(func(mock.Arguments) { close(stored) }).
+		Return(nil).Once()
+
+	service := NewService(provider, cache)
+
+	// Act
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	result, err := service.GetWeather(ctx, nameQuery("Athens"))
+	close(release)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+
+	// The fetch still completes and warms the cache for later requests
+	select {
+	case <-stored:
+	case <-time.After(time.Second):
+		t.Fatal("shared fetch was not cached after the caller gave up")
+	}
+}
+
+func TestGetWeather_StaleWhileRevalidate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cached := createSampleWeather("Athens", 20.0)
+	cached.UpdatedAt = time.Now().Add(-90 * time.Minute)
+	cached.FreshUntil = time.Now().Add(-30 * time.Minute)
+	refreshed := createSampleWeather("Athens", 25.0)
+	stored := make(chan struct{})
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(cached, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(refreshed, nil).Once()
+	cache.On("Set", mock.Anything, "current:athens", refreshed, 3*time.Hour).
+		Run(func(mock.Arguments) { close(stored) }).
+		Return(nil).Once()
+
+	service := NewService(provider, cache, WithTTLPolicy(TTLPolicy{Current: time.Hour}), WithStaleWhileRevalidate(time.Hour), WithHardTTL(3*time.Hour))
+
+	// Act
+	result, err := service.GetWeather(ctx, nameQuery("Athens"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Stale)
+	assert.Equal(t, 20.0, result.Current.Temperature.Celsius)
+	assert.False(t, cached.Stale, "cached value must not be modified")
+
+	// The entry is refreshed in the background
+	select {
+	case <-stored:
+	case <-time.After(time.Second):
+		t.Fatal("stale entry was not revalidated")
+	}
+	provider.AssertExpectations(t)
+}
+
+func TestGetWeather_StaleIfError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cached := createSampleWeather("Athens", 20.0)
+	cached.UpdatedAt = time.Now().Add(-150 * time.Minute)
+	cached.FreshUntil = time.Now().Add(-90 * time.Minute)
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(cached, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(nil, errors.New("api down"))
+
+	service := NewService(provider, cache, WithTTLPolicy(TTLPolicy{Current: time.Hour}), WithStaleWhileRevalidate(time.Hour), WithHardTTL(3*time.Hour))
+
+	// Act
+	result, err := service.GetWeather(ctx, nameQuery("Athens"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Stale)
+	assert.Equal(t, 20.0, result.Current.Temperature.Celsius)
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetWeather_PastRevalidationWindow_FetchesSynchronously(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cached := createSampleWeather("Athens", 20.0)
+	cached.UpdatedAt = time.Now().Add(-150 * time.Minute)
+	cached.FreshUntil = time.Now().Add(-90 * time.Minute)
+	fresh := createSampleWeather("Athens", 25.0)
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(cached, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(fresh, nil)
+	cache.On("Set", mock.Anything, "current:athens", fresh, 3*time.Hour).Return(nil)
+
+	service := NewService(provider, cache, WithTTLPolicy(TTLPolicy{Current: time.Hour}), WithStaleWhileRevalidate(time.Hour), WithHardTTL(3*time.Hour))
+
+	// Act
+	result, err := service.GetWeather(ctx, nameQuery("Athens"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.Stale)
+	assert.Equal(t, 25.0, result.Current.Temperature.Celsius)
+	cache.AssertExpectations(t)
+}
+
+func TestGetWeather_PastHardTTL_ReturnsProviderError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cached := createSampleWeather("Athens", 20.0)
+	cached.UpdatedAt = time.Now().Add(-4 * time.Hour)
+	cached.FreshUntil = time.Now().Add(-3 * time.Hour)
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(cached, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(nil, errors.New("api down"))
+
+	service := NewService(provider, cache, WithTTLPolicy(TTLPolicy{Current: time.Hour}), WithStaleWhileRevalidate(time.Hour), WithHardTTL(3*time.Hour))
+
+	// Act
+	result, err := service.GetWeather(ctx, nameQuery("Athens"))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, weather.ErrWeatherUnavailable)
+}
+
+func TestNewService_HardTTLCoversRevalidationWindow(t *testing.T) {
+	// Act
+	service := NewService(new(MockWeatherProvider), new(MockWeatherCache), WithTTLPolicy(TTLPolicy{Current: 2 * time.Hour}), WithStaleWhileRevalidate(time.Hour), WithHardTTL(time.Hour))
+
+	// Assert
+	assert.Equal(t, 3*time.Hour, service.hardTTL)
+}
+
+func TestGetWeather_FreshnessFollowsTTLPolicy(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expected := createSampleWeather("Athens", 25.0)
+	expected.Current.LastUpdated = time.Now().Add(-10 * time.Minute)
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:athens").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(expected, nil)
+	cache.On("Set", mock.Anything, "current:athens", expected, defaultHardTTL).Return(nil)
+
+	policy := TTLPolicy{Current: time.Hour, ObservationInterval: 15 * time.Minute}
+	service := NewService(provider, cache, WithTTLPolicy(policy))
+
+	// Act
+	result, err := service.GetWeather(ctx, nameQuery("Athens"))
+
+	// Assert
+	// Fresh until the provider's next observation is due
+	require.NoError(t, err)
+	assert.WithinDuration(t, expected.Current.LastUpdated.Add(15*time.Minute), result.FreshUntil, time.Second)
+	cache.AssertExpectations(t)
+}
@@ -0,0 +1,112 @@
+package weather
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// maxLocationBuckets bounds the number of locations whose upstream calls are counted separately
+const maxLocationBuckets = 500
+
+// Stats counts how the application services serve requests, per type of data
+// It implements the GetStatsUseCase use case and is safe for concurrent use.
+type Stats struct {
+	since time.Time
+
+	mu         sync.Mutex
+	counters   map[DataType]*weather.CacheCounters
+	byLocation map[string]int64
+}
+
+// NewStats creates a set of zeroed counters
+func NewStats() *Stats {
+	return &Stats{
+		since:      time.Now(),
+		counters:   make(map[DataType]*weather.CacheCounters),
+		byLocation: make(map[string]int64),
+	}
+}
+
+// GetStats returns a snapshot of the counters
+func (s *Stats) GetStats(_ context.Context) (*weather.ServiceStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byDataType := make(map[string]weather.CacheCounters, len(s.counters))
+	for dataType, c := range s.counters {
+		byDataType[string(dataType)] = *c
+	}
+
+	return &weather.ServiceStats{
+		Since:                   s.since,
+		ByDataType:              byDataType,
+		UpstreamCallsByLocation: maps.Clone(s.byLocation),
+	}, nil
+}
+
+// hit counts a request served fresh from cache
+func (s *Stats) hit(dataType DataType) {
+	s.update(dataType, func(c *weather.CacheCounters) { c.Hits++ })
+}
+
+// staleServe counts a request served stale from cache
+func (s *Stats) staleServe(dataType DataType) {
+	s.update(dataType, func(c *weather.CacheCounters) { c.StaleServes++ })
+}
+
+// miss counts a request that could not be served from cache
+func (s *Stats) miss(dataType DataType) {
+	s.update(dataType, func(c *weather.CacheCounters) { c.Misses++ })
+}
+
+// setFailure counts fetched data that could not be cached
+func (s *Stats) setFailure(dataType DataType) {
+	s.update(dataType, func(c *weather.CacheCounters) { c.SetFailures++ })
+}
+
+// upstreamCall counts a provider call, and the location bucket it was for unless it is empty
+func (s *Stats) upstreamCall(dataType DataType, location string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.counter(dataType).UpstreamCalls++
+	if location == "" {
+		return
+	}
+	if _, ok := s.byLocation[location]; !ok && len(s.byLocation) >= maxLocationBuckets {
+		location = weather.OtherLocations
+	}
+	s.byLocation[location]++
+}
+
+// locationBucket names the bucket counting upstream calls for a location
+// Locations are bucketed as they are cached; IP addresses share one bucket
+// rather than being reported individually.
+func locationBucket(query weather.LocationQuery) string {
+	if query.Kind == weather.LocationByIP {
+		return "ip"
+	}
+	return canonicalLocation(query)
+}
+
+// update applies a change to the counters of a data type
+func (s *Stats) update(dataType DataType, change func(*weather.CacheCounters)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	change(s.counter(dataType))
+}
+
+// counter returns the counters of a data type, creating them on first use
+func (s *Stats) counter(dataType DataType) *weather.CacheCounters {
+	c, ok := s.counters[dataType]
+	if !ok {
+		c = &weather.CacheCounters{}
+		s.counters[dataType] = c
+	}
+	return c
+}
@@ -0,0 +1,134 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+func TestGetWeather_CountsRequestOutcomes(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	fresh := createSampleWeather("London", 15.0)
+	stale := createSampleWeather("Paris", 18.0)
+	stale.UpdatedAt = time.Now().Add(-150 * time.Minute)
+	stale.FreshUntil = time.Now().Add(-90 * time.Minute)
+
+	provider := new(MockWeatherProvider)
+	cache := new(MockWeatherCache)
+
+	cache.On("Get", ctx, "current:london").Return(fresh, nil)
+	cache.On("Get", ctx, "current:paris").Return(stale, nil)
+	cache.On("Get", ctx, "current:athens").Return(nil, nil)
+	provider.On("FetchWeather", mock.Anything, nameQuery("Paris")).Return(nil, errors.New("api down"))
+	provider.On("FetchWeather", mock.Anything, nameQuery("Athens")).Return(createSampleWeather("Athens", 25.0), nil)
+	cache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	stats := NewStats()
+	service := NewService(provider, cache, WithTTLPolicy(TTLPolicy{Current: time.Hour}), WithStaleWhileRevalidate(time.Hour), WithHardTTL(3*time.Hour), WithStats(stats))
+
+	// Act
+	for _, name := range []string{"London", "London", "Paris", "Athens"} {
+		_, err := service.GetWeather(ctx, nameQuery(name))
+		require.NoError(t, err)
+	}
+	result, err := stats.GetStats(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, weather.CacheCounters{
+		Hits:          2,
+		StaleServes:   1,
+		Misses:        1,
+		SetFailures:   1,
+		UpstreamCalls: 2,
+	}, result.ByDataType["current"])
+	assert.Equal(t, map[string]int64{"paris": 1, "athens": 1}, result.UpstreamCallsByLocation)
+	assert.InDelta(t, 0.75, result.ByDataType["current"].HitRatio(), 0.001)
+}
+
+func TestStats_SharedAcrossServices(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	forecastProvider := new(MockForecastProvider)
+	forecastCache := new(MockForecastCache)
+	forecastCache.On("Get", ctx, "forecast:london:3").Return(nil, nil)
+	forecastCache.On("Set", ctx, "forecast:london:3", mock.Anything, mock.Anything).Return(nil)
+	forecastProvider.On("FetchForecast", ctx, nameQuery("London"), 3).Return(&weather.Forecast{}, nil)
+
+	weatherProvider := new(MockWeatherProvider)
+	weatherCache := new(MockWeatherCache)
+	weatherCache.On("Get", ctx, "current:london").Return(createSampleWeather("London", 15.0), nil)
+
+	stats := NewStats()
+	forecastService := NewForecastService(forecastProvider, forecastCache, WithStats(stats))
+	weatherService := NewService(weatherProvider, weatherCache, WithStats(stats))
+
+	// Act
+	_, err := forecastService.GetForecast(ctx, nameQuery("London"), 3)
+	require.NoError(t, err)
+	_, err = weatherService.GetWeather(ctx, nameQuery("London"))
+	require.NoError(t, err)
+	result, err := stats.GetStats(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, weather.CacheCounters{Misses: 1, UpstreamCalls: 1}, result.ByDataType["forecast"])
+	assert.Equal(t, weather.CacheCounters{Hits: 1}, result.ByDataType["current"])
+	assert.Equal(t, map[string]int64{"london": 1}, result.UpstreamCallsByLocation)
+}
+
+func TestStats_LocationBucketsAreBounded(t *testing.T) {
+	// Arrange
+	stats := NewStats()
+
+	// Act
+	for i := range maxLocationBuckets + 5 {
+		stats.upstreamCall(DataCurrent, fmt.Sprintf("location-%d", i))
+	}
+	stats.upstreamCall(DataCurrent, "location-0")
+	result, err := stats.GetStats(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, result.UpstreamCallsByLocation, maxLocationBuckets+1)
+	assert.Equal(t, int64(5), result.UpstreamCallsByLocation[weather.OtherLocations])
+	assert.Equal(t, int64(2), result.UpstreamCallsByLocation["location-0"], "tracked locations keep their bucket")
+}
+
+func TestStats_IPQueriesShareABucket(t *testing.T) {
+	// Arrange
+	query := weather.LocationQuery{Kind: weather.LocationByIP, IP: "203.0.113.7"}
+
+	// Act
+	bucket := locationBucket(query)
+
+	// Assert
+	assert.Equal(t, "ip", bucket)
+}
+
+func TestStats_SnapshotIsACopy(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	stats := NewStats()
+	stats.hit(DataAlerts)
+	stats.upstreamCall(DataAlerts, "london")
+
+	// Act
+	snapshot, err := stats.GetStats(ctx)
+	require.NoError(t, err)
+	stats.hit(DataAlerts)
+	stats.upstreamCall(DataAlerts, "london")
+
+	// Assert
+	assert.Equal(t, int64(1), snapshot.ByDataType["alerts"].Hits)
+	assert.Equal(t, int64(1), snapshot.UpstreamCallsByLocation["london"])
+}
@@ -0,0 +1,99 @@
+package weather
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// DataType identifies a kind of cached data with its own cache lifetime
+type DataType string
+
+// Cached data types
+const (
+	DataCurrent       DataType = "current"
+	DataForecast      DataType = "forecast"
+	DataHistory       DataType = "history"
+	DataRecentHistory DataType = "recent_history"
+	DataAlerts        DataType = "alerts"
+	DataAstronomy     DataType = "astronomy"
+	DataSearch        DataType = "search"
+)
+
+// minAlignedTTL keeps an entry cached briefly even when the provider's next
+// observation is already overdue, so a late provider doesn't cause a refetch per request
+const minAlignedTTL = time.Minute
+
+// TTLPolicy decides how long each type of data stays in cache
+// For current weather the TTL is its freshness lifetime; see Service.GetWeather.
+type TTLPolicy struct {
+	Current       time.Duration
+	Forecast      time.Duration // forecasts change as new model runs are published
+	History       time.Duration // ranges whose days are all over; past observations never change
+	RecentHistory time.Duration // ranges that include the current day, whose observations keep arriving
+	Alerts        time.Duration // alerts are safety-relevant, so they are only cached briefly
+	Astronomy     time.Duration // sun and moon times for a given date never change
+	Search        time.Duration // places rarely appear or move
+
+	// ObservationInterval is how often the provider publishes new observations
+	// When set, entries with an observation time expire when the next one is due.
+	ObservationInterval time.Duration
+
+	// Jitter shortens each TTL by a random fraction of up to this value (0 to 1),
+	// so entries cached together do not expire together
+	Jitter float64
+}
+
+// DefaultTTLPolicy returns the TTLs used when none are configured
+// WeatherAPI.com refreshes current conditions every 15 minutes.
+func DefaultTTLPolicy() TTLPolicy {
+	return TTLPolicy{
+		Current:             15 * time.Minute,
+		Forecast:            3 * time.Hour,
+		History:             30 * 24 * time.Hour,
+		RecentHistory:       time.Hour,
+		Alerts:              5 * time.Minute,
+		Astronomy:           7 * 24 * time.Hour,
+		Search:              24 * time.Hour,
+		ObservationInterval: 15 * time.Minute,
+		Jitter:              0.1,
+	}
+}
+
+// TTL returns how long data of the given type fetched at now should be cached
+// observedAt is when the provider observed the data, or the zero time if unknown.
+func (p TTLPolicy) TTL(dataType DataType, observedAt time.Time, now time.Time) time.Duration {
+	ttl := p.base(dataType)
+
+	if p.ObservationInterval > 0 && !observedAt.IsZero() {
+		untilNextObservation := observedAt.Add(p.ObservationInterval).Sub(now)
+		ttl = min(ttl, max(untilNextObservation, minAlignedTTL))
+	}
+
+	if p.Jitter > 0 {
+		ttl -= time.Duration(float64(ttl) * min(p.Jitter, 1) * rand.Float64())
+	}
+
+	return ttl
+}
+
+// base returns the configured TTL for a data type
+func (p TTLPolicy) base(dataType DataType) time.Duration {
+	switch dataType {
+	case DataCurrent:
+		return p.Current
+	case DataForecast:
+		return p.Forecast
+	case DataHistory:
+		return p.History
+	case DataRecentHistory:
+		return p.RecentHistory
+	case DataAlerts:
+		return p.Alerts
+	case DataAstronomy:
+		return p.Astronomy
+	case DataSearch:
+		return p.Search
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,95 @@
+package weather
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixedTTLPolicy is the default policy without jitter or observation alignment, so TTLs are predictable
+func fixedTTLPolicy() TTLPolicy {
+	policy := DefaultTTLPolicy()
+	policy.ObservationInterval = 0
+	policy.Jitter = 0
+	return policy
+}
+
+func TestTTLPolicy_PerDataType(t *testing.T) {
+	// Arrange
+	policy := TTLPolicy{
+		Current:       time.Minute,
+		Forecast:      2 * time.Minute,
+		History:       3 * time.Minute,
+		RecentHistory: 4 * time.Minute,
+		Alerts:        5 * time.Minute,
+		Astronomy:     6 * time.Minute,
+		Search:        7 * time.Minute,
+	}
+	now := time.Now()
+
+	// Act & Assert
+	assert.Equal(t, time.Minute, policy.TTL(DataCurrent, time.Time{}, now))
+	assert.Equal(t, 2*time.Minute, policy.TTL(DataForecast, time.Time{}, now))
+	assert.Equal(t, 3*time.Minute, policy.TTL(DataHistory, time.Time{}, now))
+	assert.Equal(t, 4*time.Minute, policy.TTL(DataRecentHistory, time.Time{}, now))
+	assert.Equal(t, 5*time.Minute, policy.TTL(DataAlerts, time.Time{}, now))
+	assert.Equal(t, 6*time.Minute, policy.TTL(DataAstronomy, time.Time{}, now))
+	assert.Equal(t, 7*time.Minute, policy.TTL(DataSearch, time.Time{}, now))
+}
+
+func TestTTLPolicy_AlignsToNextObservation(t *testing.T) {
+	// Arrange
+	policy := TTLPolicy{Current: time.Hour, ObservationInterval: 15 * time.Minute}
+	now := time.Date(2026, 3, 2, 14, 50, 0, 0, time.UTC)
+	observedAt := time.Date(2026, 3, 2, 14, 45, 0, 0, time.UTC)
+
+	// Act
+	ttl := policy.TTL(DataCurrent, observedAt, now)
+
+	// Assert
+	// The next observation is due at 15:00
+	assert.Equal(t, 10*time.Minute, ttl)
+}
+
+func TestTTLPolicy_OverdueObservation_KeepsMinimumTTL(t *testing.T) {
+	// Arrange
+	policy := TTLPolicy{Current: time.Hour, ObservationInterval: 15 * time.Minute}
+	now := time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC)
+	observedAt := time.Date(2026, 3, 2, 14, 45, 0, 0, time.UTC)
+
+	// Act
+	ttl := policy.TTL(DataCurrent, observedAt, now)
+
+	// Assert
+	assert.Equal(t, minAlignedTTL, ttl)
+}
+
+func TestTTLPolicy_AlignmentNeverExtendsTTL(t *testing.T) {
+	// Arrange
+	policy := TTLPolicy{Current: 5 * time.Minute, ObservationInterval: 15 * time.Minute}
+	now := time.Date(2026, 3, 2, 14, 46, 0, 0, time.UTC)
+	observedAt := time.Date(2026, 3, 2, 14, 45, 0, 0, time.UTC)
+
+	// Act
+	ttl := policy.TTL(DataCurrent, observedAt, now)
+
+	// Assert
+	assert.Equal(t, 5*time.Minute, ttl)
+}
+
+func TestTTLPolicy_Jitter(t *testing.T) {
+	// Arrange
+	policy := TTLPolicy{Forecast: time.Hour, Jitter: 0.2}
+	now := time.Now()
+	seen := map[time.Duration]bool{}
+
+	// Act & Assert
+	for range 100 {
+		ttl := policy.TTL(DataForecast, time.Time{}, now)
+		assert.LessOrEqual(t, ttl, time.Hour)
+		assert.GreaterOrEqual(t, ttl, 48*time.Minute)
+		seen[ttl] = true
+	}
+	assert.Greater(t, len(seen), 1, "jittered TTLs should vary")
+}
@@ -0,0 +1,60 @@
+// Package bootstrap builds the adapters and services the commands share from the configuration,
+// so the server and the warm-up command always construct them the same way.
+package bootstrap
+
+import (
+	"fmt"
+
+	"weather-api-wrapper/internal/adapters/output/config"
+	"weather-api-wrapper/internal/adapters/output/weatherapi"
+	weatherapp "weather-api-wrapper/internal/application/weather"
+)
+
+// NewWeatherAPIClient builds the WeatherAPI.com client adapter from the configuration
+// It fails if WEATHER_API_PROXY is not a valid URL.
+func NewWeatherAPIClient(cfg *config.Config) (*weatherapi.Client, error) {
+	httpClient, err := weatherapi.NewHTTPClient(weatherapi.HTTPSettings{
+		Timeout:               cfg.WeatherAPITimeout,
+		DialTimeout:           cfg.WeatherAPIDialTimeout,
+		TLSHandshakeTimeout:   cfg.WeatherAPITLSHandshakeTimeout,
+		ResponseHeaderTimeout: cfg.WeatherAPIResponseHeaderTimeout,
+		KeepAlive:             cfg.WeatherAPIKeepAlive,
+		IdleConnTimeout:       cfg.WeatherAPIIdleConnTimeout,
+		MaxIdleConns:          cfg.WeatherAPIMaxIdleConns,
+		MaxIdleConnsPerHost:   cfg.WeatherAPIMaxIdleConns,
+		MaxConnsPerHost:       cfg.WeatherAPIMaxConnsPerHost,
+		ProxyURL:              cfg.WeatherAPIProxy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid WEATHER_API_PROXY: %w", err)
+	}
+
+	return weatherapi.NewClient(cfg.WeatherAPIKey, cfg.WeatherAPIBaseURL,
+		weatherapi.WithHTTPClient(httpClient),
+		weatherapi.WithCallTimeout(cfg.WeatherAPICallTimeout),
+		weatherapi.WithRetryPolicy(weatherapi.RetryPolicy{
+			MaxAttempts: cfg.WeatherAPIMaxAttempts,
+			BaseDelay:   cfg.WeatherAPIRetryBaseDelay,
+			MaxDelay:    cfg.WeatherAPIRetryMaxDelay,
+		}),
+	), nil
+}
+
+// ServiceOptions builds the application service options from the configuration
+func ServiceOptions(cfg *config.Config) []weatherapp.Option {
+	return []weatherapp.Option{
+		weatherapp.WithTTLPolicy(weatherapp.TTLPolicy{
+			Current:             cfg.CacheTTLCurrent,
+			Forecast:            cfg.CacheTTLForecast,
+			History:             cfg.CacheTTLHistory,
+			RecentHistory:       cfg.CacheTTLRecentHistory,
+			Alerts:              cfg.CacheTTLAlerts,
+			Astronomy:           cfg.CacheTTLAstronomy,
+			Search:              cfg.CacheTTLSearch,
+			ObservationInterval: cfg.CacheObservationInterval,
+			Jitter:              cfg.CacheTTLJitter,
+		}),
+		weatherapp.WithStaleWhileRevalidate(cfg.CacheStaleWhileRevalidate),
+		weatherapp.WithHardTTL(cfg.CacheHardTTL),
+	}
+}
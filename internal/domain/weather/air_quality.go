@@ -0,0 +1,83 @@
+package weather
+
+// AirQuality holds pollutant concentrations and air quality indices
+// Concentrations are expressed in μg/m3
+type AirQuality struct {
+	CarbonMonoxide  float64 // CO
+	NitrogenDioxide float64 // NO2
+	Ozone           float64 // O3
+	SulphurDioxide  float64 // SO2
+	PM2_5           float64 // Fine particulate matter (<= 2.5 μm)
+	PM10            float64 // Coarse particulate matter (<= 10 μm)
+	USEPAIndex      int     // US EPA index, 1 (Good) to 6 (Hazardous)
+	UKDEFRAIndex    int     // UK DEFRA Daily Air Quality Index, 1 (Low) to 10 (Very High)
+}
+
+// Business Methods - Rich Domain Behavior
+
+// GetUSEPACategory returns the US EPA category name for the index
+func (aq AirQuality) GetUSEPACategory() string {
+	switch aq.USEPAIndex {
+	case 1:
+		return "Good"
+	case 2:
+		return "Moderate"
+	case 3:
+		return "Unhealthy for Sensitive Groups"
+	case 4:
+		return "Unhealthy"
+	case 5:
+		return "Very Unhealthy"
+	case 6:
+		return "Hazardous"
+	default:
+		return "Unknown"
+	}
+}
+
+// GetUKDEFRABand returns the UK DEFRA band for the index
+func (aq AirQuality) GetUKDEFRABand() string {
+	idx := aq.UKDEFRAIndex
+	switch {
+	case idx >= 1 && idx <= 3:
+		return "Low"
+	case idx >= 4 && idx <= 6:
+		return "Moderate"
+	case idx >= 7 && idx <= 9:
+		return "High"
+	case idx == 10:
+		return "Very High"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsUnhealthy returns true if the air is unhealthy for the general population (US EPA >= 4)
+func (aq AirQuality) IsUnhealthy() bool {
+	return aq.USEPAIndex >= 4
+}
+
+// IsUnhealthyForSensitiveGroups returns true if at-risk individuals should take precautions (US EPA >= 3)
+func (aq AirQuality) IsUnhealthyForSensitiveGroups() bool {
+	return aq.USEPAIndex >= 3
+}
+
+// GetHealthAdvice returns general health advice based on the US EPA index
+func (aq AirQuality) GetHealthAdvice() string {
+	switch aq.USEPAIndex {
+	case 1:
+		return "Air quality is satisfactory. Enjoy your usual outdoor activities."
+	case 2:
+		return "Air quality is acceptable. Unusually sensitive people should consider reducing prolonged outdoor exertion."
+	case 3:
+		return "Sensitive groups should reduce prolonged or heavy outdoor exertion."
+	case 4:
+		return "Everyone should reduce prolonged or heavy outdoor exertion. Sensitive groups should avoid it."
+	case 5:
+		return "Everyone should avoid prolonged or heavy outdoor exertion. Sensitive groups should remain indoors."
+	case 6:
+		return "Health alert: everyone should avoid all outdoor physical activity."
+	default:
+		return "No air quality advice available."
+	}
+}
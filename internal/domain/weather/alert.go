@@ -0,0 +1,80 @@
+package weather
+
+import (
+	"strings"
+	"time"
+)
+
+// Alert is a government-issued severe weather alert
+type Alert struct {
+	Headline    string
+	MessageType string
+	Severity    string // Extreme, Severe, Moderate, Minor or Unknown
+	Urgency     string // Immediate, Expected, Future, Past or Unknown
+	Certainty   string
+	Category    string
+	Event       string
+	Areas       []string
+	Note        string
+	Effective   time.Time
+	Expires     time.Time
+	Description string
+	Instruction string
+}
+
+// WeatherAlerts is the domain entity representing the alerts issued for a location
+type WeatherAlerts struct {
+	Location  Location
+	Alerts    []Alert
+	UpdatedAt time.Time
+}
+
+// Business Methods - Rich Domain Behavior
+
+// IsActive returns true if the alert is in effect at the given time
+// A zero Effective or Expires time leaves that side of the window open
+func (a Alert) IsActive(at time.Time) bool {
+	if !a.Effective.IsZero() && at.Before(a.Effective) {
+		return false
+	}
+	if !a.Expires.IsZero() && !at.Before(a.Expires) {
+		return false
+	}
+	return true
+}
+
+// IsExpired returns true if the alert is no longer in effect at the given time
+func (a Alert) IsExpired(at time.Time) bool {
+	return !a.Expires.IsZero() && !at.Before(a.Expires)
+}
+
+// IsSevere returns true if the alert has Severe or Extreme severity
+func (a Alert) IsSevere() bool {
+	switch strings.ToLower(a.Severity) {
+	case "severe", "extreme":
+		return true
+	default:
+		return false
+	}
+}
+
+// Unexpired returns the alerts that are active or have not yet taken effect at the given time
+func (wa WeatherAlerts) Unexpired(at time.Time) []Alert {
+	alerts := make([]Alert, 0, len(wa.Alerts))
+	for _, alert := range wa.Alerts {
+		if !alert.IsExpired(at) {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+// HasActiveSevereAlert returns true if a Severe or Extreme alert is in effect at the given time
+func (w Weather) HasActiveSevereAlert(at time.Time) bool {
+	for _, alert := range w.Alerts {
+		if alert.IsSevere() && alert.IsActive(at) {
+			return true
+		}
+	}
+	return false
+}
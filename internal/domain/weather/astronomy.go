@@ -0,0 +1,163 @@
+package weather
+
+import (
+	"math"
+	"time"
+)
+
+// Astronomy holds sun and moon data for a single day
+// Times are zero when the event does not happen that day (e.g. no moonrise,
+// or no sunrise during polar night)
+type Astronomy struct {
+	Sunrise          time.Time
+	Sunset           time.Time
+	Moonrise         time.Time
+	Moonset          time.Time
+	MoonPhase        string
+	MoonIllumination int
+}
+
+// AstronomyReport is the domain entity representing astronomy data for a location and date
+type AstronomyReport struct {
+	Location  Location
+	Date      time.Time
+	Astronomy Astronomy
+	Estimated bool // true when computed locally instead of reported by a provider
+	UpdatedAt time.Time
+}
+
+// TimeWindow is a closed interval of time
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// goldenHourLength approximates how long the sun stays low enough for golden-hour light
+const goldenHourLength = time.Hour
+
+// Business Methods - Rich Domain Behavior
+
+// HasSunrise returns true if both sunrise and sunset are known for the day
+func (a Astronomy) HasSunrise() bool {
+	return !a.Sunrise.IsZero() && !a.Sunset.IsZero()
+}
+
+// DaylightDuration returns the time between sunrise and sunset
+// It is zero when the sun does not rise or set that day
+func (a Astronomy) DaylightDuration() time.Duration {
+	if !a.HasSunrise() || a.Sunset.Before(a.Sunrise) {
+		return 0
+	}
+	return a.Sunset.Sub(a.Sunrise)
+}
+
+// IsSunUp returns true if the given time is between sunrise and sunset
+func (a Astronomy) IsSunUp(at time.Time) bool {
+	return a.HasSunrise() && !at.Before(a.Sunrise) && at.Before(a.Sunset)
+}
+
+// GoldenHours returns the morning and evening golden-hour windows,
+// approximated as the hour after sunrise and the hour before sunset
+// The boolean is false when the sun does not rise or set that day
+func (a Astronomy) GoldenHours() (morning TimeWindow, evening TimeWindow, ok bool) {
+	if !a.HasSunrise() {
+		return TimeWindow{}, TimeWindow{}, false
+	}
+
+	length := goldenHourLength
+	if daylight := a.DaylightDuration(); daylight < 2*length {
+		// Very short days: split the daylight between the two windows
+		length = daylight / 2
+	}
+
+	morning = TimeWindow{Start: a.Sunrise, End: a.Sunrise.Add(length)}
+	evening = TimeWindow{Start: a.Sunset.Add(-length), End: a.Sunset}
+	return morning, evening, true
+}
+
+// Local astronomy calculations, used when no provider data is available
+
+const (
+	// synodicMonth is the mean length of a lunar cycle in days
+	synodicMonth = 29.530588853
+
+	// officialZenith is the solar zenith angle at sunrise/sunset in degrees,
+	// accounting for atmospheric refraction and the sun's apparent radius
+	officialZenith = 90.833
+)
+
+// referenceNewMoon is a known new moon (2000-01-06 18:14 UTC) used to compute the lunar age
+var referenceNewMoon = time.Date(2000, time.January, 6, 18, 14, 0, 0, time.UTC)
+
+// CalculateAstronomy estimates sun times and the moon phase for a date at the given coordinates
+// The calendar date is taken in date's timezone and the results are expressed in it.
+// Moonrise and moonset are not estimated and are left zero.
+func CalculateAstronomy(latitude, longitude float64, date time.Time) Astronomy {
+	sunrise, sunset := calculateSunTimes(latitude, longitude, date)
+	phase, illumination := calculateMoonPhase(date)
+
+	return Astronomy{
+		Sunrise:          sunrise,
+		Sunset:           sunset,
+		MoonPhase:        phase,
+		MoonIllumination: illumination,
+	}
+}
+
+// calculateSunTimes implements the NOAA sunrise/sunset equations
+// Both times are zero during polar day or polar night
+func calculateSunTimes(latitude, longitude float64, date time.Time) (sunrise, sunset time.Time) {
+	tz := date.Location()
+	midnightUTC := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Fractional year (radians) at solar noon
+	gamma := 2 * math.Pi / 365 * float64(date.YearDay()-1)
+
+	// Equation of time (minutes) and solar declination (radians)
+	eqTime := 229.18 * (0.000075 + 0.001868*math.Cos(gamma) - 0.032077*math.Sin(gamma) -
+		0.014615*math.Cos(2*gamma) - 0.040849*math.Sin(2*gamma))
+	decl := 0.006918 - 0.399912*math.Cos(gamma) + 0.070257*math.Sin(gamma) -
+		0.006758*math.Cos(2*gamma) + 0.000907*math.Sin(2*gamma) -
+		0.002697*math.Cos(3*gamma) + 0.00148*math.Sin(3*gamma)
+
+	lat := latitude * math.Pi / 180
+	cosHourAngle := math.Cos(officialZenith*math.Pi/180)/(math.Cos(lat)*math.Cos(decl)) - math.Tan(lat)*math.Tan(decl)
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, time.Time{}
+	}
+	hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+
+	// Minutes after midnight UTC
+	sunriseMinutes := 720 - 4*(longitude+hourAngle) - eqTime
+	sunsetMinutes := 720 - 4*(longitude-hourAngle) - eqTime
+
+	sunrise = midnightUTC.Add(time.Duration(sunriseMinutes * float64(time.Minute))).In(tz)
+	sunset = midnightUTC.Add(time.Duration(sunsetMinutes * float64(time.Minute))).In(tz)
+	return sunrise.Truncate(time.Minute), sunset.Truncate(time.Minute)
+}
+
+// calculateMoonPhase derives the moon phase name and illumination percentage from the lunar age
+func calculateMoonPhase(date time.Time) (string, int) {
+	days := date.Sub(referenceNewMoon).Hours() / 24
+	age := math.Mod(days, synodicMonth)
+	if age < 0 {
+		age += synodicMonth
+	}
+
+	illumination := (1 - math.Cos(2*math.Pi*age/synodicMonth)) / 2 * 100
+
+	// Each of the eight phases spans an eighth of the cycle, centred on its nominal age
+	phases := []string{
+		"New Moon",
+		"Waxing Crescent",
+		"First Quarter",
+		"Waxing Gibbous",
+		"Full Moon",
+		"Waning Gibbous",
+		"Last Quarter",
+		"Waning Crescent",
+	}
+	index := int(math.Floor(age/synodicMonth*8+0.5)) % len(phases)
+
+	return phases[index], int(math.Round(illumination))
+}
@@ -0,0 +1,24 @@
+package weather
+
+import "time"
+
+// CacheEntry describes a cache entry without its value, for cache administration
+type CacheEntry struct {
+	Key     string
+	AliasOf string        // key an alias points to; empty for values
+	TTL     time.Duration // remaining lifetime; zero when the entry never expires
+
+	// Set by caches that record them: when the value was stored and which provider it came from
+	StoredAt time.Time
+	Provider string
+}
+
+// CachedValue is a cache entry together with the value it resolves to
+// Value is nil when the entry expired or its alias target is gone.
+type CachedValue[T any] struct {
+	CacheEntry
+	Value *T
+}
+
+// CachedWeather is a cached current weather entry together with the data it resolves to
+type CachedWeather = CachedValue[Weather]
@@ -7,12 +7,51 @@ var (
 	// ErrInvalidLocation indicates that the provided location is invalid
 	ErrInvalidLocation = errors.New("invalid location: location cannot be empty")
 
+	// ErrInvalidCoordinates indicates that a latitude or longitude is malformed or out of range
+	ErrInvalidCoordinates = errors.New("invalid coordinates: latitude must be between -90 and 90 and longitude between -180 and 180")
+
+	// ErrInvalidPostcode indicates that a postal code is malformed
+	ErrInvalidPostcode = errors.New("invalid postcode: must be 3 to 10 letters, digits, spaces or hyphens")
+
+	// ErrInvalidIATACode indicates that an airport code is not a three-letter IATA code
+	ErrInvalidIATACode = errors.New("invalid IATA code: must be three letters")
+
+	// ErrInvalidIPAddress indicates that an IP address could not be parsed
+	ErrInvalidIPAddress = errors.New("invalid IP address")
+
+	// ErrInvalidSearchQuery indicates that a location search query is too short
+	ErrInvalidSearchQuery = errors.New("invalid search query: must be at least 2 characters")
+
 	// ErrWeatherNotFound indicates that weather data was not found for the requested location
 	ErrWeatherNotFound = errors.New("weather data not found")
 
 	// ErrWeatherUnavailable indicates that the weather service is unavailable
 	ErrWeatherUnavailable = errors.New("weather service unavailable")
 
+	// ErrQuotaExceeded indicates that the weather provider refused a request because the service used up its quota
+	ErrQuotaExceeded = errors.New("weather provider quota exceeded")
+
+	// ErrProviderMisconfigured indicates that the weather provider rejected the service's credentials or plan
+	ErrProviderMisconfigured = errors.New("weather provider misconfigured")
+
+	// ErrInvalidForecastDays indicates that the requested number of forecast days is out of range
+	ErrInvalidForecastDays = errors.New("invalid forecast days: must be between 1 and 14")
+
+	// ErrInvalidDate indicates that a date is not in the expected YYYY-MM-DD format
+	ErrInvalidDate = errors.New("invalid date: expected format YYYY-MM-DD")
+
+	// ErrInvalidDateRange indicates that a historical date range is out of order, in the future or too long
+	ErrInvalidDateRange = errors.New("invalid date range: dates must be between 2010-01-01 and today, in order, and span at most 30 days")
+
+	// ErrInvalidUnits indicates that an unsupported unit system was requested
+	ErrInvalidUnits = errors.New("invalid units: must be metric, imperial or si")
+
+	// ErrInvalidCachePrefix indicates that a cache invalidation was requested without a key prefix
+	ErrInvalidCachePrefix = errors.New("invalid cache prefix: prefix cannot be empty")
+
+	// ErrCacheEntryNotFound indicates that no cache entry exists under the requested key
+	ErrCacheEntryNotFound = errors.New("cache entry not found")
+
 	// ErrCacheUnavailable indicates that the cache service is unavailable
 	ErrCacheUnavailable = errors.New("cache service unavailable")
 )
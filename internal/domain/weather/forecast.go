@@ -0,0 +1,100 @@
+package weather
+
+import "time"
+
+// Forecast is the domain entity representing a multi-day forecast for a location
+type Forecast struct {
+	Location  Location
+	Days      []DailyWeather
+	UpdatedAt time.Time
+}
+
+// DailyWeather summarizes the meteorological conditions of a single calendar day
+type DailyWeather struct {
+	Date          time.Time
+	MaxTemp       TemperatureValue
+	MinTemp       TemperatureValue
+	AvgTemp       TemperatureValue
+	MaxWind       Wind
+	TotalPrecip   Precipitation
+	TotalSnowCm   float64
+	AvgVisibility Distance
+	AvgHumidity   int
+	ChanceOfRain  int
+	ChanceOfSnow  int
+	WillItRain    bool
+	WillItSnow    bool
+	Condition     Condition
+	UVIndex       float64
+	Astronomy     Astronomy
+	Hours         []HourlyWeather
+}
+
+// HourlyWeather represents the meteorological conditions for a single hour
+type HourlyWeather struct {
+	Time          time.Time
+	Temperature   Temperature
+	Condition     Condition
+	Wind          Wind
+	Pressure      Pressure
+	Precipitation Precipitation
+	SnowCm        float64
+	Humidity      int
+	CloudCover    int
+	Visibility    Distance
+	UVIndex       float64
+	ChanceOfRain  int
+	ChanceOfSnow  int
+	WillItRain    bool
+	WillItSnow    bool
+	IsDay         bool
+}
+
+// Business Methods - Rich Domain Behavior
+
+// IsRainLikely returns true if rain is expected or its chance is at least 50%
+func (d DailyWeather) IsRainLikely() bool {
+	return d.WillItRain || d.ChanceOfRain >= 50
+}
+
+// IsSnowLikely returns true if snow is expected or its chance is at least 50%
+func (d DailyWeather) IsSnowLikely() bool {
+	return d.WillItSnow || d.ChanceOfSnow >= 50
+}
+
+// TemperatureSpread returns the difference between the day's max and min temperature in Celsius
+func (d DailyWeather) TemperatureSpread() float64 {
+	return d.MaxTemp.Celsius - d.MinTemp.Celsius
+}
+
+// WarmestDay returns the day with the highest maximum temperature
+// The boolean is false if the forecast has no days
+func (f Forecast) WarmestDay() (DailyWeather, bool) {
+	if len(f.Days) == 0 {
+		return DailyWeather{}, false
+	}
+
+	warmest := f.Days[0]
+	for _, day := range f.Days[1:] {
+		if day.MaxTemp.Celsius > warmest.MaxTemp.Celsius {
+			warmest = day
+		}
+	}
+	return warmest, true
+}
+
+// ColdestDay returns the day with the lowest minimum temperature
+// The boolean is false if the forecast has no days
+func (f Forecast) ColdestDay() (DailyWeather, bool) {
+	if len(f.Days) == 0 {
+		return DailyWeather{}, false
+	}
+
+	coldest := f.Days[0]
+	for _, day := range f.Days[1:] {
+		if day.MinTemp.Celsius < coldest.MinTemp.Celsius {
+			coldest = day
+		}
+	}
+	return coldest, true
+}
@@ -0,0 +1,45 @@
+package weather
+
+import "time"
+
+// CircuitState is the state of the circuit breaker guarding an upstream provider
+type CircuitState string
+
+// Circuit breaker states
+const (
+	CircuitClosed   CircuitState = "closed"    // calls go through
+	CircuitOpen     CircuitState = "open"      // calls fail fast
+	CircuitHalfOpen CircuitState = "half-open" // a few trial calls go through
+)
+
+// UpstreamHealth describes the health of an upstream provider as seen by its circuit breaker
+type UpstreamHealth struct {
+	Name  string
+	State CircuitState
+	Since time.Time // when the breaker entered its state
+
+	// Calls and failures counted in the breaker's current window
+	Requests int
+	Failures int
+}
+
+// Available reports whether calls to the upstream are let through
+func (u UpstreamHealth) Available() bool {
+	return u.State != CircuitOpen
+}
+
+// HealthReport describes the health of the service and its upstream providers
+type HealthReport struct {
+	Upstreams []UpstreamHealth
+}
+
+// Degraded reports whether an upstream provider is unavailable
+// A degraded service still serves cached data.
+func (h HealthReport) Degraded() bool {
+	for _, u := range h.Upstreams {
+		if !u.Available() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package weather
+
+import "time"
+
+// History is the domain entity representing observed weather for a range of past days
+type History struct {
+	Location  Location
+	Days      []DailyWeather
+	UpdatedAt time.Time
+}
+
+// IsFinal returns true if every day in the history is over in the location's timezone
+// Observations for a finished day no longer change, so a final history is immutable
+func (h History) IsFinal(now time.Time) bool {
+	if len(h.Days) == 0 {
+		return false
+	}
+
+	last := h.Days[len(h.Days)-1].Date
+	localNow := now.In(last.Location())
+	today := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, last.Location())
+
+	return last.Before(today)
+}
@@ -0,0 +1,167 @@
+package weather
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// LocationKind identifies how a location is specified in a query
+type LocationKind string
+
+// Supported ways of specifying a location
+const (
+	LocationByName        LocationKind = "name"
+	LocationByCoordinates LocationKind = "coordinates"
+	LocationByPostcode    LocationKind = "postcode"
+	LocationByIATA        LocationKind = "iata"
+	LocationByIP          LocationKind = "ip"
+)
+
+// Valid coordinate ranges in decimal degrees
+const (
+	MinLatitude  = -90.0
+	MaxLatitude  = 90.0
+	MinLongitude = -180.0
+	MaxLongitude = 180.0
+)
+
+// Postcode length limits, covering formats from 3-digit to 10-character codes
+const (
+	minPostcodeLength = 3
+	maxPostcodeLength = 10
+)
+
+// LocationQuery is a value object describing the location weather is requested for
+// Only the fields relevant to its Kind are set; use the New*Query constructors to build a valid query
+// It is comparable, so it can be used as a map key
+type LocationQuery struct {
+	Kind      LocationKind
+	Name      string // City or place name, postcode or IATA code
+	Latitude  float64
+	Longitude float64
+	IP        string // Normalized IP address
+}
+
+// NewNameQuery creates a query for a city or place name
+func NewNameQuery(name string) (LocationQuery, error) {
+	if err := ValidateLocation(name); err != nil {
+		return LocationQuery{}, err
+	}
+	return LocationQuery{Kind: LocationByName, Name: strings.TrimSpace(name)}, nil
+}
+
+// NewCoordinatesQuery creates a query for a latitude/longitude pair in decimal degrees
+func NewCoordinatesQuery(latitude, longitude float64) (LocationQuery, error) {
+	// Written as in-range checks so NaN, which fails every comparison, is rejected
+	if !(latitude >= MinLatitude && latitude <= MaxLatitude) || !(longitude >= MinLongitude && longitude <= MaxLongitude) {
+		return LocationQuery{}, ErrInvalidCoordinates
+	}
+	return LocationQuery{Kind: LocationByCoordinates, Latitude: latitude, Longitude: longitude}, nil
+}
+
+// ParseCoordinatesQuery parses latitude and longitude strings into a coordinates query
+func ParseCoordinatesQuery(latitude, longitude string) (LocationQuery, error) {
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latitude), 64)
+	if err != nil {
+		return LocationQuery{}, ErrInvalidCoordinates
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(longitude), 64)
+	if err != nil {
+		return LocationQuery{}, ErrInvalidCoordinates
+	}
+	return NewCoordinatesQuery(lat, lon)
+}
+
+// NewPostcodeQuery creates a query for a postal code
+// Postcodes are letters, digits, spaces and hyphens, and are normalized to upper case
+func NewPostcodeQuery(postcode string) (LocationQuery, error) {
+	postcode = strings.ToUpper(strings.TrimSpace(postcode))
+	if len(postcode) < minPostcodeLength || len(postcode) > maxPostcodeLength {
+		return LocationQuery{}, ErrInvalidPostcode
+	}
+	for _, r := range postcode {
+		if !isASCIILetter(r) && !isASCIIDigit(r) && r != ' ' && r != '-' {
+			return LocationQuery{}, ErrInvalidPostcode
+		}
+	}
+	return LocationQuery{Kind: LocationByPostcode, Name: postcode}, nil
+}
+
+// NewIATAQuery creates a query for an airport by its three-letter IATA code
+func NewIATAQuery(code string) (LocationQuery, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return LocationQuery{}, ErrInvalidIATACode
+	}
+	for _, r := range code {
+		if !isASCIILetter(r) {
+			return LocationQuery{}, ErrInvalidIATACode
+		}
+	}
+	return LocationQuery{Kind: LocationByIATA, Name: code}, nil
+}
+
+// NewIPQuery creates a query for the location of an IPv4 or IPv6 address
+func NewIPQuery(address string) (LocationQuery, error) {
+	ip := net.ParseIP(strings.TrimSpace(address))
+	if ip == nil {
+		return LocationQuery{}, ErrInvalidIPAddress
+	}
+	return LocationQuery{Kind: LocationByIP, IP: ip.String()}, nil
+}
+
+// Validate checks that the query is well formed for its kind
+// It guards use cases against queries that were not built with a constructor
+func (q LocationQuery) Validate() error {
+	var err error
+	switch q.Kind {
+	case LocationByName:
+		_, err = NewNameQuery(q.Name)
+	case LocationByCoordinates:
+		_, err = NewCoordinatesQuery(q.Latitude, q.Longitude)
+	case LocationByPostcode:
+		_, err = NewPostcodeQuery(q.Name)
+	case LocationByIATA:
+		_, err = NewIATAQuery(q.Name)
+	case LocationByIP:
+		_, err = NewIPQuery(q.IP)
+	default:
+		err = ErrInvalidLocation
+	}
+	return err
+}
+
+// HasCoordinates returns true if the query itself carries a latitude and longitude
+func (q LocationQuery) HasCoordinates() bool {
+	return q.Kind == LocationByCoordinates
+}
+
+// String returns a compact, unambiguous representation of the query
+// Names are returned as is; other kinds are prefixed so they cannot collide with a place name
+func (q LocationQuery) String() string {
+	switch q.Kind {
+	case LocationByCoordinates:
+		return formatCoordinate(q.Latitude) + "," + formatCoordinate(q.Longitude)
+	case LocationByPostcode:
+		return "postcode:" + q.Name
+	case LocationByIATA:
+		return "iata:" + q.Name
+	case LocationByIP:
+		return "ip:" + q.IP
+	default:
+		return q.Name
+	}
+}
+
+func formatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,41 @@
+package weather
+
+import (
+	"strings"
+	"time"
+)
+
+// MinSearchQueryLength is the shortest partial name accepted by a location search
+const MinSearchQueryLength = 2
+
+// LocationSearch is the domain entity holding the candidate locations matching a partial name
+type LocationSearch struct {
+	Query      string
+	Candidates []LocationCandidate
+	UpdatedAt  time.Time
+}
+
+// LocationCandidate is a location that matches a search
+// ID is the provider's identifier, which stays stable when several places share a name
+type LocationCandidate struct {
+	ID        int
+	Name      string
+	Region    string
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+// NormalizeSearchQuery trims a search query and checks it is long enough to be useful
+func NormalizeSearchQuery(query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if len([]rune(query)) < MinSearchQueryLength {
+		return "", ErrInvalidSearchQuery
+	}
+	return query, nil
+}
+
+// IsAmbiguous returns true if more than one location matches the search
+func (s LocationSearch) IsAmbiguous() bool {
+	return len(s.Candidates) > 1
+}
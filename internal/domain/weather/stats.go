@@ -0,0 +1,50 @@
+package weather
+
+import "time"
+
+// OtherLocations is the location bucket counting the locations beyond the tracked ones
+const OtherLocations = "other"
+
+// CacheCounters counts how requests for one type of data were served
+type CacheCounters struct {
+	Hits          int64 // served fresh from cache
+	StaleServes   int64 // served stale from cache, while revalidating or after a provider failure
+	Misses        int64 // fetched from the provider, or failed
+	SetFailures   int64 // fetched data that could not be cached
+	UpstreamCalls int64 // provider calls, including background refreshes
+}
+
+// HitRatio returns the share of requests served from cache, fresh or stale
+// It is 0 when there were no requests.
+func (c CacheCounters) HitRatio() float64 {
+	served := c.Hits + c.StaleServes
+	if total := served + c.Misses; total > 0 {
+		return float64(served) / float64(total)
+	}
+	return 0
+}
+
+// ServiceStats is a snapshot of the request counters since Since
+type ServiceStats struct {
+	Since time.Time
+
+	// ByDataType holds the counters of each type of data, such as "current" or "forecast"
+	ByDataType map[string]CacheCounters
+
+	// UpstreamCallsByLocation counts provider calls per location, as normalized for
+	// cache keys; locations beyond the tracked ones are counted under OtherLocations
+	UpstreamCallsByLocation map[string]int64
+}
+
+// Total adds up the counters of every type of data
+func (s ServiceStats) Total() CacheCounters {
+	var total CacheCounters
+	for _, c := range s.ByDataType {
+		total.Hits += c.Hits
+		total.StaleServes += c.StaleServes
+		total.Misses += c.Misses
+		total.SetFailures += c.SetFailures
+		total.UpstreamCalls += c.UpstreamCalls
+	}
+	return total
+}
@@ -0,0 +1,127 @@
+package weather
+
+import (
+	"math"
+	"strings"
+)
+
+// UnitSystem identifies the system of measurement used to present weather data
+// Domain values are converted from their metric representation, so every system is derived from a single source
+type UnitSystem string
+
+// Supported unit systems
+const (
+	UnitsMetric   UnitSystem = "metric"   // °C, km/h, mb, mm, km
+	UnitsImperial UnitSystem = "imperial" // °F, mph, inHg, in, mi
+	UnitsSI       UnitSystem = "si"       // K, m/s, Pa, mm, m
+)
+
+// DefaultUnitSystem is used when neither the client nor the server configuration chooses one
+const DefaultUnitSystem = UnitsMetric
+
+// Conversion factors from metric units
+const (
+	kelvinOffset       = 273.15
+	kilometersPerMile  = 1.609344
+	millimetersPerInch = 25.4
+	inHgPerMillibar    = 0.0295299830714
+	pascalsPerMillibar = 100
+)
+
+// conversionPrecision is the number of decimal places kept after a conversion
+const conversionPrecision = 2
+
+// UnitLabels names the unit of each kind of measurement in a unit system
+type UnitLabels struct {
+	Temperature   string
+	Speed         string
+	Pressure      string
+	Precipitation string
+	Distance      string
+}
+
+// ParseUnitSystem parses a unit system name, ignoring case and surrounding whitespace
+func ParseUnitSystem(value string) (UnitSystem, error) {
+	switch units := UnitSystem(strings.ToLower(strings.TrimSpace(value))); units {
+	case UnitsMetric, UnitsImperial, UnitsSI:
+		return units, nil
+	default:
+		return "", ErrInvalidUnits
+	}
+}
+
+// Labels returns the unit names used by the unit system
+func (u UnitSystem) Labels() UnitLabels {
+	switch u {
+	case UnitsImperial:
+		return UnitLabels{Temperature: "°F", Speed: "mph", Pressure: "inHg", Precipitation: "in", Distance: "mi"}
+	case UnitsSI:
+		return UnitLabels{Temperature: "K", Speed: "m/s", Pressure: "Pa", Precipitation: "mm", Distance: "m"}
+	default:
+		return UnitLabels{Temperature: "°C", Speed: "km/h", Pressure: "mb", Precipitation: "mm", Distance: "km"}
+	}
+}
+
+// Temperature converts a temperature in Celsius to the unit system
+func (u UnitSystem) Temperature(celsius float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(celsius*9/5 + 32)
+	case UnitsSI:
+		return round(celsius + kelvinOffset)
+	default:
+		return round(celsius)
+	}
+}
+
+// Speed converts a speed in km/h to the unit system
+func (u UnitSystem) Speed(kph float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(kph / kilometersPerMile)
+	case UnitsSI:
+		return round(kph / 3.6)
+	default:
+		return round(kph)
+	}
+}
+
+// Pressure converts a pressure in millibars to the unit system
+func (u UnitSystem) Pressure(millibars float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(millibars * inHgPerMillibar)
+	case UnitsSI:
+		return round(millibars * pascalsPerMillibar)
+	default:
+		return round(millibars)
+	}
+}
+
+// Precipitation converts a precipitation amount in millimeters to the unit system
+func (u UnitSystem) Precipitation(millimeters float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(millimeters / millimetersPerInch)
+	default:
+		return round(millimeters)
+	}
+}
+
+// Distance converts a distance in kilometers to the unit system
+func (u UnitSystem) Distance(kilometers float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(kilometers / kilometersPerMile)
+	case UnitsSI:
+		return round(kilometers * 1000)
+	default:
+		return round(kilometers)
+	}
+}
+
+// round limits a converted value to conversionPrecision decimal places
+func round(value float64) float64 {
+	factor := math.Pow(10, conversionPrecision)
+	return math.Round(value*factor) / factor
+}
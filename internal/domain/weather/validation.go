@@ -1,6 +1,9 @@
 package weather
 
-import "strings"
+import (
+	"strings"
+	"time"
+)
 
 // ValidateLocation validates a location string for weather queries
 func ValidateLocation(location string) error {
@@ -17,3 +20,57 @@ func ValidateLocation(location string) error {
 
 	return nil
 }
+
+// Forecast range supported by the domain
+const (
+	MinForecastDays = 1
+	MaxForecastDays = 14
+)
+
+// ValidateForecastDays validates the number of days requested for a forecast
+func ValidateForecastDays(days int) error {
+	if days < MinForecastDays || days > MaxForecastDays {
+		return ErrInvalidForecastDays
+	}
+
+	return nil
+}
+
+// DateLayout is the calendar date format used for historical queries
+const DateLayout = "2006-01-02"
+
+// MaxHistoryRangeDays is the longest date range, inclusive, a history query may cover
+const MaxHistoryRangeDays = 30
+
+// earliestHistoryDate is the first day historical observations are available for
+var earliestHistoryDate = time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ParseDate parses a YYYY-MM-DD calendar date
+func ParseDate(value string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	return date, nil
+}
+
+// ValidateHistoryRange validates an inclusive range of past dates
+// Dates up to one day after now are accepted, since "today" in timezones
+// ahead of UTC may already be tomorrow's date
+func ValidateHistoryRange(from, to, now time.Time) error {
+	latest := now.UTC().AddDate(0, 0, 1)
+
+	switch {
+	case from.Before(earliestHistoryDate):
+		return ErrInvalidDateRange
+	case to.Before(from):
+		return ErrInvalidDateRange
+	case to.After(latest):
+		return ErrInvalidDateRange
+	case to.Sub(from) >= MaxHistoryRangeDays*24*time.Hour:
+		return ErrInvalidDateRange
+	}
+
+	return nil
+}
@@ -4,9 +4,12 @@ import "time"
 
 // Weather is the core domain entity representing weather information for a location
 type Weather struct {
-	Location  Location
-	Current   CurrentWeather
-	UpdatedAt time.Time
+	Location   Location
+	Current    CurrentWeather
+	Alerts     []Alert
+	UpdatedAt  time.Time
+	FreshUntil time.Time // end of the freshness lifetime assigned when cached
+	Stale      bool      // true when served from cache past its freshness lifetime
 }
 
 // Location represents geographic information
@@ -22,18 +25,19 @@ type Location struct {
 
 // CurrentWeather represents current meteorological conditions
 type CurrentWeather struct {
-	LastUpdated    time.Time
-	Temperature    Temperature
-	Condition      Condition
-	Wind           Wind
-	Pressure       Pressure
-	Precipitation  Precipitation
-	Humidity       int
-	CloudCover     int
-	Visibility     Distance
-	UVIndex        float64
-	IsDay          bool
-	Radiation      Radiation
+	LastUpdated   time.Time
+	Temperature   Temperature
+	Condition     Condition
+	Wind          Wind
+	Pressure      Pressure
+	Precipitation Precipitation
+	Humidity      int
+	CloudCover    int
+	Visibility    Distance
+	UVIndex       float64
+	IsDay         bool
+	Radiation     Radiation
+	AirQuality    *AirQuality // nil when the provider did not report air quality
 }
 
 // Temperature holds temperature measurements in different units
@@ -188,6 +192,28 @@ func (w Wind) GetBeaufortScale() int {
 	}
 }
 
+// GetBeaufortDescription returns the Beaufort scale name for the wind speed
+func (w Wind) GetBeaufortDescription() string {
+	return beaufortDescriptions[w.GetBeaufortScale()]
+}
+
+// beaufortDescriptions is indexed by Beaufort scale number
+var beaufortDescriptions = [...]string{
+	"Calm",
+	"Light Air",
+	"Light Breeze",
+	"Gentle Breeze",
+	"Moderate Breeze",
+	"Fresh Breeze",
+	"Strong Breeze",
+	"Near Gale",
+	"Gale",
+	"Strong Gale",
+	"Storm",
+	"Violent Storm",
+	"Hurricane",
+}
+
 // IsRaining returns true if there is measurable precipitation
 func (p Precipitation) IsRaining() bool {
 	return p.Millimeters > 0
@@ -279,9 +305,19 @@ func (w Weather) GetFullDescription() string {
 }
 
 // IsExtreme returns true if weather conditions are extreme
+// or a Severe/Extreme government alert is currently in effect
 func (w Weather) IsExtreme() bool {
 	return w.Current.Temperature.IsExtreme() ||
 		w.Current.Wind.IsGale() ||
 		w.Current.Precipitation.IsHeavyRain() ||
-		w.Current.IsPoorVisibility()
+		w.Current.IsPoorVisibility() ||
+		w.HasActiveSevereAlert(time.Now())
+}
+
+// Age returns how long ago the data was fetched from the provider
+func (w Weather) Age(now time.Time) time.Duration {
+	if age := now.Sub(w.UpdatedAt); age > 0 {
+		return age
+	}
+	return 0
 }
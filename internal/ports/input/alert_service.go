@@ -0,0 +1,15 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// GetAlertsUseCase defines the business capability to retrieve severe weather alerts
+// This is a primary/driving port used by external actors (like HTTP handlers)
+type GetAlertsUseCase interface {
+	// GetAlerts retrieves the unexpired weather alerts issued for a location
+	// It returns domain alert data or a domain error
+	GetAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error)
+}
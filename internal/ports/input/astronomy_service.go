@@ -0,0 +1,16 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// GetAstronomyUseCase defines the business capability to retrieve sun and moon data
+// This is a primary/driving port used by external actors (like HTTP handlers)
+type GetAstronomyUseCase interface {
+	// GetAstronomy retrieves sunrise, sunset, moonrise, moonset and moon phase for a date
+	// It returns domain astronomy data or a domain error
+	GetAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error)
+}
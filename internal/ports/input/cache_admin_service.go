@@ -0,0 +1,27 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// CacheAdminUseCase defines the capability to inspect and invalidate cached weather data
+// This is a primary/driving port used by operators through the admin HTTP endpoints
+type CacheAdminUseCase interface {
+	// ListCachedWeather lists the current weather entries whose keys start with "current:" + prefix
+	ListCachedWeather(ctx context.Context, prefix string) ([]weather.CachedWeather, error)
+
+	// GetCacheEntry returns the entry stored under a cache key, resolving aliases to their data
+	// It returns ErrCacheEntryNotFound if the key doesn't exist
+	GetCacheEntry(ctx context.Context, key string) (*weather.CachedWeather, error)
+
+	// InvalidateLocation removes every cached entry for a location and returns how many were removed
+	InvalidateLocation(ctx context.Context, query weather.LocationQuery) (int, error)
+
+	// InvalidatePrefix removes the entries whose keys start with prefix and returns how many were removed
+	InvalidatePrefix(ctx context.Context, prefix string) (int, error)
+
+	// FlushCache removes every cached entry and returns how many were removed
+	FlushCache(ctx context.Context) (int, error)
+}
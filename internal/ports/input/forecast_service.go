@@ -0,0 +1,15 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// GetForecastUseCase defines the business capability to retrieve multi-day forecasts
+// This is a primary/driving port used by external actors (like HTTP handlers)
+type GetForecastUseCase interface {
+	// GetForecast retrieves a forecast covering the given number of days for a location
+	// It returns domain forecast data or a domain error
+	GetForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error)
+}
@@ -0,0 +1,14 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// HealthCheckUseCase defines the capability to report the health of the service
+// This is a primary/driving port used by the health HTTP endpoint
+type HealthCheckUseCase interface {
+	// CheckHealth reports the health of the upstream providers
+	CheckHealth(ctx context.Context) (*weather.HealthReport, error)
+}
@@ -0,0 +1,16 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// GetHistoryUseCase defines the business capability to retrieve observed weather for past dates
+// This is a primary/driving port used by external actors (like HTTP handlers)
+type GetHistoryUseCase interface {
+	// GetHistory retrieves observed weather for each day between from and to, inclusive
+	// A single date is requested by passing the same value for both
+	GetHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error)
+}
@@ -0,0 +1,15 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// SearchLocationsUseCase defines the business capability to find locations by partial name
+// This is a primary/driving port used by external actors (like HTTP handlers)
+type SearchLocationsUseCase interface {
+	// SearchLocations retrieves the candidate locations matching a partial name
+	// It returns domain search results or a domain error
+	SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error)
+}
@@ -0,0 +1,14 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// GetStatsUseCase defines the capability to report how requests are served
+// This is a primary/driving port used by the stats HTTP endpoint
+type GetStatsUseCase interface {
+	// GetStats returns cache and upstream counters per type of data
+	GetStats(ctx context.Context) (*weather.ServiceStats, error)
+}
@@ -0,0 +1,15 @@
+package input
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// WarmUpCacheUseCase defines the capability to preload the cache with the current weather of locations
+// This is a primary/driving port used by the warm-up command
+type WarmUpCacheUseCase interface {
+	// WarmUp fetches and caches the current weather of each location
+	// It returns how many locations were cached
+	WarmUp(ctx context.Context, queries []weather.LocationQuery) (int, error)
+}
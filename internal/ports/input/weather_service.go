@@ -12,5 +12,5 @@ import (
 type GetWeatherUseCase interface {
 	// GetWeather retrieves weather information for a given location
 	// It returns domain weather data or a domain error
-	GetWeather(ctx context.Context, location string) (*weather.Weather, error)
+	GetWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error)
 }
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// AlertCache abstracts caching mechanisms for weather alerts
+// This is a secondary/driven port, the alerts counterpart of WeatherCache
+type AlertCache interface {
+	// Get retrieves weather alerts from cache for a given key
+	// Returns nil and no error if the key doesn't exist (cache miss)
+	Get(ctx context.Context, key string) (*weather.WeatherAlerts, error)
+
+	// Set stores weather alerts in cache with a time-to-live duration
+	Set(ctx context.Context, key string, data *weather.WeatherAlerts, ttl time.Duration) error
+}
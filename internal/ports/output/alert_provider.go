@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// AlertProvider abstracts external sources of government weather alerts
+// This is a secondary/driven port implemented by weather API adapters
+type AlertProvider interface {
+	// FetchAlerts retrieves the weather alerts issued for a location
+	// It returns domain alert data or an error
+	FetchAlerts(ctx context.Context, query weather.LocationQuery) (*weather.WeatherAlerts, error)
+}
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// AstronomyCache abstracts caching mechanisms for astronomy data
+// This is a secondary/driven port, the astronomy counterpart of WeatherCache
+type AstronomyCache interface {
+	// Get retrieves astronomy data from cache for a given key
+	// Returns nil and no error if the key doesn't exist (cache miss)
+	Get(ctx context.Context, key string) (*weather.AstronomyReport, error)
+
+	// Set stores astronomy data in cache with a time-to-live duration
+	Set(ctx context.Context, key string, data *weather.AstronomyReport, ttl time.Duration) error
+}
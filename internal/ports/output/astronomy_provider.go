@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// AstronomyProvider abstracts external sources of astronomy data
+// This is a secondary/driven port implemented by weather API adapters
+type AstronomyProvider interface {
+	// FetchAstronomy retrieves sun and moon data for a location and date
+	// It returns domain astronomy data or an error
+	FetchAstronomy(ctx context.Context, query weather.LocationQuery, date time.Time) (*weather.AstronomyReport, error)
+}
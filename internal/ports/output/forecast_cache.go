@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// ForecastCache abstracts caching mechanisms for forecast data
+// This is a secondary/driven port, the forecast counterpart of WeatherCache
+type ForecastCache interface {
+	// Get retrieves a forecast from cache for a given key
+	// Returns nil and no error if the key doesn't exist (cache miss)
+	Get(ctx context.Context, key string) (*weather.Forecast, error)
+
+	// Set stores a forecast in cache with a time-to-live duration
+	Set(ctx context.Context, key string, data *weather.Forecast, ttl time.Duration) error
+}
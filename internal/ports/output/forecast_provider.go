@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// ForecastProvider abstracts external sources of multi-day forecasts
+// This is a secondary/driven port implemented by weather API adapters
+type ForecastProvider interface {
+	// FetchForecast retrieves a forecast covering the given number of days
+	// It returns domain forecast data or an error
+	FetchForecast(ctx context.Context, query weather.LocationQuery, days int) (*weather.Forecast, error)
+}
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// HistoryCache abstracts caching mechanisms for historical weather data
+// This is a secondary/driven port, the history counterpart of WeatherCache
+type HistoryCache interface {
+	// Get retrieves historical weather from cache for a given key
+	// Returns nil and no error if the key doesn't exist (cache miss)
+	Get(ctx context.Context, key string) (*weather.History, error)
+
+	// Set stores historical weather in cache with a time-to-live duration
+	Set(ctx context.Context, key string, data *weather.History, ttl time.Duration) error
+}
@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// HistoryProvider abstracts external sources of historical weather observations
+// This is a secondary/driven port implemented by weather API adapters
+type HistoryProvider interface {
+	// FetchHistory retrieves observed weather for each day between from and to, inclusive
+	// It returns domain history data or an error
+	FetchHistory(ctx context.Context, query weather.LocationQuery, from, to time.Time) (*weather.History, error)
+}
@@ -0,0 +1,28 @@
+package output
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// ManagedCache abstracts the administration of a cache, whatever the data it holds
+// This is a secondary/driven port used to list, describe and remove entries;
+// every cache adapter implements it alongside its data-specific port
+type ManagedCache interface {
+	// Namespace identifies the store the cache lists its keys from
+	// Caches sharing a store, such as the typed caches on one Redis, report the
+	// same namespace and list the same keys.
+	Namespace() string
+
+	// Keys lists the keys starting with prefix; an empty prefix lists every key
+	// It is meant for administration and may be slow on large caches
+	Keys(ctx context.Context, prefix string) ([]string, error)
+
+	// Inspect describes the entry stored under key without following aliases
+	// Returns nil and no error if the key doesn't exist
+	Inspect(ctx context.Context, key string) (*weather.CacheEntry, error)
+
+	// Delete removes the given keys and returns how many existed
+	Delete(ctx context.Context, keys ...string) (int, error)
+}
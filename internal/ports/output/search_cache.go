@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// LocationSearchCache abstracts caching mechanisms for location search results
+// This is a secondary/driven port, the search counterpart of WeatherCache
+type LocationSearchCache interface {
+	// Get retrieves search results from cache for a given key
+	// Returns nil and no error if the key doesn't exist (cache miss)
+	Get(ctx context.Context, key string) (*weather.LocationSearch, error)
+
+	// Set stores search results in cache with a time-to-live duration
+	Set(ctx context.Context, key string, data *weather.LocationSearch, ttl time.Duration) error
+}
@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+
+	"weather-api-wrapper/internal/domain/weather"
+)
+
+// LocationSearchProvider abstracts external location search and autocomplete sources
+// This is a secondary/driven port implemented by weather API adapters
+type LocationSearchProvider interface {
+	// SearchLocations retrieves the locations matching a partial name
+	// It returns domain search results or an error
+	SearchLocations(ctx context.Context, query string) (*weather.LocationSearch, error)
+}
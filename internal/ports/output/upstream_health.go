@@ -0,0 +1,10 @@
+package output
+
+import "weather-api-wrapper/internal/domain/weather"
+
+// UpstreamHealthReporter reports the health of an upstream provider
+// This is a secondary/driven port implemented by provider decorators that
+// track upstream failures, such as a circuit breaker
+type UpstreamHealthReporter interface {
+	UpstreamHealth() weather.UpstreamHealth
+}
@@ -11,11 +11,27 @@ import (
 // This is a secondary/driven port that defines how the application
 // stores and retrieves weather data from cache (like Redis)
 type WeatherCache interface {
-	// Get retrieves weather data from cache for a given location
+	// Get retrieves weather data from cache for a given key, following aliases
 	// Returns nil and no error if the key doesn't exist (cache miss)
-	Get(ctx context.Context, location string) (*weather.Weather, error)
+	Get(ctx context.Context, key string) (*weather.Weather, error)
+
+	// Peek retrieves weather data like Get, but leaves the cache's own bookkeeping,
+	// such as recency and per-tier hit counts, untouched
+	// It is meant for administration, whose reads say nothing about demand.
+	Peek(ctx context.Context, key string) (*weather.Weather, error)
 
 	// Set stores weather data in cache with a time-to-live duration
 	// The cache implementation should handle serialization
-	Set(ctx context.Context, location string, data *weather.Weather, ttl time.Duration) error
+	Set(ctx context.Context, key string, data *weather.Weather, ttl time.Duration) error
+
+	// Alias makes alias resolve to the entry stored under key for the given time-to-live
+	// An alias whose entry has expired resolves to a cache miss
+	Alias(ctx context.Context, alias, key string, ttl time.Duration) error
+
+	// List lists the entries whose keys start with prefix together with the data they
+	// resolve to; an empty prefix lists every entry
+	// It is meant for administration and may be slow on large caches
+	List(ctx context.Context, prefix string) ([]weather.CachedWeather, error)
+
+	ManagedCache
 }
@@ -12,5 +12,5 @@ import (
 type WeatherProvider interface {
 	// FetchWeather retrieves weather information from an external source
 	// It returns domain weather data or an error
-	FetchWeather(ctx context.Context, location string) (*weather.Weather, error)
+	FetchWeather(ctx context.Context, query weather.LocationQuery) (*weather.Weather, error)
 }